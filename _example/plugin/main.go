@@ -6,6 +6,7 @@ import (
 	"github.com/maramkhaledn/protolint/_example/plugin/customrules"
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 	"github.com/maramkhaledn/protolint/plugin"
 )
 
@@ -18,7 +19,7 @@ func main() {
 
 	plugin.RegisterCustomRules(
 		// The purpose of this line just illustrates that you can implement the same as internal linter rules.
-		rules.NewEnumsHaveCommentRule(rule.SeverityWarning, *goStyle),
+		rules.NewEnumsHaveCommentRule(rule.SeverityWarning, *goStyle, visitor.Env{}),
 
 		// A common custom rule example. It's simple.
 		customrules.NewEnumNamesLowerSnakeCaseRule(),
@@ -2,6 +2,7 @@ module github.com/maramkhaledn/protolint
 
 require (
 	github.com/BurntSushi/toml v1.5.0
+	github.com/bmatcuk/doublestar/v4 v4.10.2
 	github.com/chavacava/garif v0.1.0
 	github.com/gertd/go-pluralize v0.2.1
 	github.com/golang/protobuf v1.5.4
@@ -21,6 +21,7 @@ type EnumFieldNamesPrefixRule struct {
 	RuleWithSeverity
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewEnumFieldNamesPrefixRule creates a new EnumFieldNamesPrefixRule.
@@ -28,6 +29,7 @@ func NewEnumFieldNamesPrefixRule(
 	severity rule.Severity,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) EnumFieldNamesPrefixRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -36,6 +38,7 @@ func NewEnumFieldNamesPrefixRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -49,14 +52,32 @@ func (r EnumFieldNamesPrefixRule) Purpose() string {
 	return `Verifies that enum field names are prefixed with its ENUM_NAME_UPPER_SNAKE_CASE.`
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r EnumFieldNamesPrefixRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "Enum values share the scope of the enclosing package in C++, so the prefix of the enum name avoids the collisions between the enums.",
+		Bad: `enum FooBar {
+  UNSPECIFIED = 0;
+}`,
+		Good: `enum FooBar {
+  FOO_BAR_UNSPECIFIED = 0;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r EnumFieldNamesPrefixRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r EnumFieldNamesPrefixRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesPrefixRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +103,7 @@ func (v *enumFieldNamesPrefixVisitor) VisitEnum(enum *parser.Enum) bool {
 func (v *enumFieldNamesPrefixVisitor) VisitEnumField(field *parser.EnumField) bool {
 	expectedPrefix := strs.ToUpperSnakeCase(v.enumName)
 	if !strings.HasPrefix(field.Ident, expectedPrefix) {
-		v.AddFailuref(field.Meta.Pos, "EnumField name %q should have the prefix %q", field.Ident, expectedPrefix)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, field.Ident, "EnumField name %q should have the prefix %q", field.Ident, expectedPrefix)
 
 		expected := expectedPrefix + "_" + field.Ident
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
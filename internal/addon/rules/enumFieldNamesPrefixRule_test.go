@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestEnumFieldNamesPrefixRule_Apply(t *testing.T) {
@@ -147,7 +148,7 @@ func TestEnumFieldNamesPrefixRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewEnumFieldNamesPrefixRule(rule.SeverityError, false, autodisable.Noop)
+			rule := rules.NewEnumFieldNamesPrefixRule(rule.SeverityError, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -182,7 +183,7 @@ func TestEnumFieldNamesPrefixRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumFieldNamesPrefixRule(rule.SeverityError, true, autodisable.Noop)
+			r := rules.NewEnumFieldNamesPrefixRule(rule.SeverityError, true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -217,7 +218,7 @@ func TestEnumFieldNamesPrefixRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumFieldNamesPrefixRule(rule.SeverityError, true, test.inputPlacementType)
+			r := rules.NewEnumFieldNamesPrefixRule(rule.SeverityError, true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -17,6 +17,7 @@ type EnumFieldNamesUpperSnakeCaseRule struct {
 	RuleWithSeverity
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewEnumFieldNamesUpperSnakeCaseRule creates a new EnumFieldNamesUpperSnakeCaseRule.
@@ -24,6 +25,7 @@ func NewEnumFieldNamesUpperSnakeCaseRule(
 	severity rule.Severity,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) EnumFieldNamesUpperSnakeCaseRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -32,6 +34,7 @@ func NewEnumFieldNamesUpperSnakeCaseRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -45,14 +48,34 @@ func (r EnumFieldNamesUpperSnakeCaseRule) Purpose() string {
 	return "Verifies that all enum field names are CAPITALS_WITH_UNDERSCORES."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r EnumFieldNamesUpperSnakeCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the enum values in CAPITALS_WITH_UNDERSCORES to tell them from the fields and the types at a glance.",
+		Bad: `enum Foo {
+  firstValue = 0;
+  second_value = 1;
+}`,
+		Good: `enum Foo {
+  FIRST_VALUE = 0;
+  SECOND_VALUE = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r EnumFieldNamesUpperSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r EnumFieldNamesUpperSnakeCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesUpperSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -72,7 +95,7 @@ func (v *enumFieldNamesUpperSnakeCaseVisitor) VisitEnumField(field *parser.EnumF
 	name := field.Ident
 	if !strs.IsUpperSnakeCase(name) {
 		expected := strs.ToUpperSnakeCase(name)
-		v.AddFailuref(field.Meta.Pos, "EnumField name %q must be CAPITALS_WITH_UNDERSCORES like %q", name, expected)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, name, "EnumField name %q must be CAPITALS_WITH_UNDERSCORES like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.Next()
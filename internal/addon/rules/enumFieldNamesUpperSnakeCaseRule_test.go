@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestEnumFieldNamesUpperSnakeCaseRule_Apply(t *testing.T) {
@@ -107,7 +108,7 @@ func TestEnumFieldNamesUpperSnakeCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewEnumFieldNamesUpperSnakeCaseRule(rule.SeverityError, false, autodisable.Noop)
+			rule := rules.NewEnumFieldNamesUpperSnakeCaseRule(rule.SeverityError, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -142,7 +143,7 @@ func TestEnumFieldNamesUpperSnakeCaseRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumFieldNamesUpperSnakeCaseRule(rule.SeverityError, true, autodisable.Noop)
+			r := rules.NewEnumFieldNamesUpperSnakeCaseRule(rule.SeverityError, true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -177,7 +178,7 @@ func TestEnumFieldNamesUpperSnakeCaseRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumFieldNamesUpperSnakeCaseRule(rule.SeverityError, true, test.inputPlacementType)
+			r := rules.NewEnumFieldNamesUpperSnakeCaseRule(rule.SeverityError, true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
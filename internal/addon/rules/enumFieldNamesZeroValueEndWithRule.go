@@ -25,6 +25,7 @@ type EnumFieldNamesZeroValueEndWithRule struct {
 	suffix          string
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewEnumFieldNamesZeroValueEndWithRule creates a new EnumFieldNamesZeroValueEndWithRule.
@@ -33,6 +34,7 @@ func NewEnumFieldNamesZeroValueEndWithRule(
 	suffix string,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) EnumFieldNamesZeroValueEndWithRule {
 	if len(suffix) == 0 {
 		suffix = defaultSuffix
@@ -45,6 +47,7 @@ func NewEnumFieldNamesZeroValueEndWithRule(
 		suffix:           suffix,
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -58,14 +61,32 @@ func (r EnumFieldNamesZeroValueEndWithRule) Purpose() string {
 	return `Verifies that the zero value enum should have the suffix (e.g. "UNSPECIFIED", "INVALID").`
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r EnumFieldNamesZeroValueEndWithRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The zero value is the default of an unset field, so naming it like UNSPECIFIED keeps the unset field from being read as a meaningful value.",
+		Bad: `enum Foo {
+  FOO_FIRST = 0;
+}`,
+		Good: `enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r EnumFieldNamesZeroValueEndWithRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r EnumFieldNamesZeroValueEndWithRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r EnumFieldNamesZeroValueEndWithRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +106,7 @@ type enumFieldNamesZeroValueEndWithVisitor struct {
 // VisitEnumField checks the enum field.
 func (v *enumFieldNamesZeroValueEndWithVisitor) VisitEnumField(field *parser.EnumField) bool {
 	if field.Number == "0" && !strings.HasSuffix(field.Ident, v.suffix) {
-		v.AddFailuref(field.Meta.Pos, "EnumField name %q with zero value should have the suffix %q", field.Ident, v.suffix)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, field.Ident, "EnumField name %q with zero value should have the suffix %q", field.Ident, v.suffix)
 
 		expected := field.Ident + "_" + v.suffix
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestEnumFieldNamesZeroValueEndWithRule_Apply(t *testing.T) {
@@ -89,7 +90,7 @@ func TestEnumFieldNamesZeroValueEndWithRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewEnumFieldNamesZeroValueEndWithRule(rule.SeverityError, test.inputSuffix, false, autodisable.Noop)
+			rule := rules.NewEnumFieldNamesZeroValueEndWithRule(rule.SeverityError, test.inputSuffix, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -124,7 +125,7 @@ func TestEnumFieldNamesZeroValueEndWithRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumFieldNamesZeroValueEndWithRule(rule.SeverityError, "", true, autodisable.Noop)
+			r := rules.NewEnumFieldNamesZeroValueEndWithRule(rule.SeverityError, "", true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -159,7 +160,7 @@ func TestEnumFieldNamesZeroValueEndWithRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumFieldNamesZeroValueEndWithRule(rule.SeverityError, "", true, test.inputPlacementType)
+			r := rules.NewEnumFieldNamesZeroValueEndWithRule(rule.SeverityError, "", true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
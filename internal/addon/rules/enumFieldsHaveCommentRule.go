@@ -14,16 +14,19 @@ type EnumFieldsHaveCommentRule struct {
 	// Golang style comments should begin with the name of the thing being described.
 	// See https://github.com/golang/go/wiki/CodeReviewComments#comment-sentences
 	shouldFollowGolangStyle bool
+	env                     visitor.Env
 }
 
 // NewEnumFieldsHaveCommentRule creates a new EnumFieldsHaveCommentRule.
 func NewEnumFieldsHaveCommentRule(
 	severity rule.Severity,
 	shouldFollowGolangStyle bool,
+	env visitor.Env,
 ) EnumFieldsHaveCommentRule {
 	return EnumFieldsHaveCommentRule{
 		RuleWithSeverity:        RuleWithSeverity{severity: severity},
 		shouldFollowGolangStyle: shouldFollowGolangStyle,
+		env:                     env,
 	}
 }
 
@@ -37,6 +40,20 @@ func (r EnumFieldsHaveCommentRule) Purpose() string {
 	return "Verifies that all enum fields have a comment."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r EnumFieldsHaveCommentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A comment on each enum value tells the readers of the generated code what the value means without reading its usages.",
+		Bad: `enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+		Good: `enum Foo {
+  // FOO_UNSPECIFIED means the value is not set.
+  FOO_UNSPECIFIED = 0;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r EnumFieldsHaveCommentRule) IsOfficial() bool {
 	return false
@@ -45,7 +62,7 @@ func (r EnumFieldsHaveCommentRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r EnumFieldsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumFieldsHaveCommentVisitor{
-		BaseAddVisitor:          visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor:          visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		shouldFollowGolangStyle: r.shouldFollowGolangStyle,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
@@ -60,9 +77,9 @@ type enumFieldsHaveCommentVisitor struct {
 func (v *enumFieldsHaveCommentVisitor) VisitEnumField(enumField *parser.EnumField) bool {
 	n := enumField.Ident
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(enumField.Comments, n) {
-		v.AddFailuref(enumField.Meta.Pos, `EnumField %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(enumField.Meta.Pos, enumField.Meta.LastPos, n, `EnumField %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(enumField.Comments, enumField.InlineComment) {
-		v.AddFailuref(enumField.Meta.Pos, `EnumField %q should have a comment`, n)
+		v.AddFailureAtNamef(enumField.Meta.Pos, enumField.Meta.LastPos, n, `EnumField %q should have a comment`, n)
 	}
 	return false
 }
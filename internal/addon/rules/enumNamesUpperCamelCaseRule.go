@@ -18,6 +18,7 @@ type EnumNamesUpperCamelCaseRule struct {
 	RuleWithSeverity
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewEnumNamesUpperCamelCaseRule creates a new EnumNamesUpperCamelCaseRule.
@@ -25,6 +26,7 @@ func NewEnumNamesUpperCamelCaseRule(
 	severity rule.Severity,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) EnumNamesUpperCamelCaseRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -33,6 +35,7 @@ func NewEnumNamesUpperCamelCaseRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -46,14 +49,32 @@ func (r EnumNamesUpperCamelCaseRule) Purpose() string {
 	return "Verifies that all enum names are CamelCase (with an initial capital)."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r EnumNamesUpperCamelCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the enums in CamelCase like the messages, which fits the type names in the generated code.",
+		Bad: `enum foobar {
+  FIRST_VALUE = 0;
+}`,
+		Good: `enum FooBar {
+  FIRST_VALUE = 0;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r EnumNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r EnumNamesUpperCamelCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r EnumNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +94,7 @@ func (v *enumNamesUpperCamelCaseVisitor) VisitEnum(enum *parser.Enum) bool {
 	name := enum.EnumName
 	if !strs.IsUpperCamelCase(name) {
 		expected := strs.ToUpperCamelCase(name)
-		v.AddFailuref(enum.Meta.Pos, "Enum name %q must be UpperCamelCase like %q", name, expected)
+		v.AddFailureAtNamef(enum.Meta.Pos, enum.Meta.LastPos, name, "Enum name %q must be UpperCamelCase like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(enum.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
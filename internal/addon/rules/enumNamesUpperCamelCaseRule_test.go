@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestEnumNamesUpperCamelCaseRule_Apply(t *testing.T) {
@@ -100,7 +101,7 @@ func TestEnumNamesUpperCamelCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewEnumNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop)
+			rule := rules.NewEnumNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -135,7 +136,7 @@ func TestEnumNamesUpperCamelCaseRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop)
+			r := rules.NewEnumNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -170,7 +171,7 @@ func TestEnumNamesUpperCamelCaseRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewEnumNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType)
+			r := rules.NewEnumNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
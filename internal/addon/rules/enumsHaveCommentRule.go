@@ -14,16 +14,19 @@ type EnumsHaveCommentRule struct {
 	// Golang style comments should begin with the name of the thing being described.
 	// See https://github.com/golang/go/wiki/CodeReviewComments#comment-sentences
 	shouldFollowGolangStyle bool
+	env                     visitor.Env
 }
 
 // NewEnumsHaveCommentRule creates a new EnumsHaveCommentRule.
 func NewEnumsHaveCommentRule(
 	severity rule.Severity,
 	shouldFollowGolangStyle bool,
+	env visitor.Env,
 ) EnumsHaveCommentRule {
 	return EnumsHaveCommentRule{
 		RuleWithSeverity:        RuleWithSeverity{severity: severity},
 		shouldFollowGolangStyle: shouldFollowGolangStyle,
+		env:                     env,
 	}
 }
 
@@ -37,6 +40,20 @@ func (r EnumsHaveCommentRule) Purpose() string {
 	return "Verifies that all enums have a comment."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r EnumsHaveCommentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A comment on each enum documents the type for the readers of the generated code.",
+		Bad: `enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+		Good: `// Foo is the kind of the foo.
+enum Foo {
+  FOO_UNSPECIFIED = 0;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r EnumsHaveCommentRule) IsOfficial() bool {
 	return false
@@ -45,7 +62,7 @@ func (r EnumsHaveCommentRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r EnumsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &enumsHaveCommentVisitor{
-		BaseAddVisitor:          visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor:          visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		shouldFollowGolangStyle: r.shouldFollowGolangStyle,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
@@ -60,9 +77,9 @@ type enumsHaveCommentVisitor struct {
 func (v *enumsHaveCommentVisitor) VisitEnum(enum *parser.Enum) bool {
 	n := enum.EnumName
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(enum.Comments, n) {
-		v.AddFailuref(enum.Meta.Pos, `Enum %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(enum.Meta.Pos, enum.Meta.LastPos, n, `Enum %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(enum.Comments, enum.InlineComment, enum.InlineCommentBehindLeftCurly) {
-		v.AddFailuref(enum.Meta.Pos, `Enum %q should have a comment`, n)
+		v.AddFailureAtNamef(enum.Meta.Pos, enum.Meta.LastPos, n, `Enum %q should have a comment`, n)
 	}
 	return true
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestEnumsHaveCommentRule_Apply(t *testing.T) {
@@ -176,7 +177,7 @@ func TestEnumsHaveCommentRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewEnumsHaveCommentRule(rule.SeverityError, test.inputShouldFollowGolangStyle)
+			rule := rules.NewEnumsHaveCommentRule(rule.SeverityError, test.inputShouldFollowGolangStyle, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -46,6 +46,7 @@ type FieldNamesExcludePrepositionsRule struct {
 	RuleWithSeverity
 	prepositions []string
 	excludes     []string
+	env          visitor.Env
 }
 
 // NewFieldNamesExcludePrepositionsRule creates a new FieldNamesExcludePrepositionsRule.
@@ -53,6 +54,7 @@ func NewFieldNamesExcludePrepositionsRule(
 	severity rule.Severity,
 	prepositions []string,
 	excludes []string,
+	env visitor.Env,
 ) FieldNamesExcludePrepositionsRule {
 	if len(prepositions) == 0 {
 		prepositions = defaultPrepositions
@@ -61,6 +63,7 @@ func NewFieldNamesExcludePrepositionsRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		prepositions:     prepositions,
 		excludes:         excludes,
+		env:              env,
 	}
 }
 
@@ -74,6 +77,19 @@ func (r FieldNamesExcludePrepositionsRule) Purpose() string {
 	return `Verifies that all field names don't include prepositions (e.g. "for", "during", "at").`
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r FieldNamesExcludePrepositionsRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The API design guide keeps prepositions out of the field names, because they often hide a concept which deserves its own field or message.",
+		Bad: `message Book {
+  string author_for_review = 1;
+}`,
+		Good: `message Book {
+  string reviewer = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r FieldNamesExcludePrepositionsRule) IsOfficial() bool {
 	return false
@@ -82,7 +98,7 @@ func (r FieldNamesExcludePrepositionsRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r FieldNamesExcludePrepositionsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fieldNamesExcludePrepositionsVisitor{
-		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor: visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		prepositions:   r.prepositions,
 		excludes:       r.excludes,
 	}
@@ -105,7 +121,7 @@ func (v *fieldNamesExcludePrepositionsVisitor) VisitField(field *parser.Field) b
 	parts := strs.SplitSnakeCaseWord(name)
 	for _, p := range parts {
 		if stringsutil.ContainsStringInSlice(p, v.prepositions) {
-			v.AddFailuref(field.Meta.Pos, "Field name %q should not include a preposition %q", field.FieldName, p)
+			v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, name, "Field name %q should not include a preposition %q", field.FieldName, p)
 		}
 	}
 	return false
@@ -121,7 +137,7 @@ func (v *fieldNamesExcludePrepositionsVisitor) VisitMapField(field *parser.MapFi
 	parts := strs.SplitSnakeCaseWord(name)
 	for _, p := range parts {
 		if stringsutil.ContainsStringInSlice(p, v.prepositions) {
-			v.AddFailuref(field.Meta.Pos, "Field name %q should not include a preposition %q", field.MapName, p)
+			v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, name, "Field name %q should not include a preposition %q", field.MapName, p)
 		}
 	}
 	return false
@@ -137,7 +153,7 @@ func (v *fieldNamesExcludePrepositionsVisitor) VisitOneofField(field *parser.One
 	parts := strs.SplitSnakeCaseWord(name)
 	for _, p := range parts {
 		if stringsutil.ContainsStringInSlice(p, v.prepositions) {
-			v.AddFailuref(field.Meta.Pos, "Field name %q should not include a preposition %q", field.FieldName, p)
+			v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, name, "Field name %q should not include a preposition %q", field.FieldName, p)
 		}
 	}
 	return false
@@ -19,6 +19,7 @@ type FieldNamesLowerSnakeCaseRule struct {
 	RuleWithSeverity
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewFieldNamesLowerSnakeCaseRule creates a new FieldNamesLowerSnakeCaseRule.
@@ -26,6 +27,7 @@ func NewFieldNamesLowerSnakeCaseRule(
 	severity rule.Severity,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) FieldNamesLowerSnakeCaseRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -34,6 +36,7 @@ func NewFieldNamesLowerSnakeCaseRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -47,14 +50,32 @@ func (r FieldNamesLowerSnakeCaseRule) Purpose() string {
 	return "Verifies that all field names are underscore_separated_names."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r FieldNamesLowerSnakeCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the fields in lower_snake_case, which every code generator converts to the idiomatic names of its language.",
+		Bad: `message SongServerRequest {
+  string SongName = 1;
+}`,
+		Good: `message SongServerRequest {
+  string song_name = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r FieldNamesLowerSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r FieldNamesLowerSnakeCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r FieldNamesLowerSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -74,7 +95,7 @@ func (v *fieldNamesLowerSnakeCaseVisitor) VisitField(field *parser.Field) bool {
 	name := field.FieldName
 	if !strs.IsLowerSnakeCase(name) {
 		expected := strs.ToLowerSnakeCase(name)
-		v.AddFailuref(field.Meta.Pos, "Field name %q must be underscore_separated_names like %q", name, expected)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, name, "Field name %q must be underscore_separated_names like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
@@ -103,7 +124,7 @@ func (v *fieldNamesLowerSnakeCaseVisitor) VisitMapField(field *parser.MapField)
 	name := field.MapName
 	if !strs.IsLowerSnakeCase(name) {
 		expected := strs.ToLowerSnakeCase(name)
-		v.AddFailuref(field.Meta.Pos, "Field name %q must be underscore_separated_names like %q", name, expected)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, name, "Field name %q must be underscore_separated_names like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
@@ -131,7 +152,7 @@ func (v *fieldNamesLowerSnakeCaseVisitor) VisitOneofField(field *parser.OneofFie
 	name := field.FieldName
 	if !strs.IsLowerSnakeCase(name) {
 		expected := strs.ToLowerSnakeCase(name)
-		v.AddFailuref(field.Meta.Pos, "Field name %q must be underscore_separated_names like %q", name, expected)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, name, "Field name %q must be underscore_separated_names like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			parseType(lex)
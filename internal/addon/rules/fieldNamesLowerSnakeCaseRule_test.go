@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestFieldNamesLowerSnakeCaseRule_Apply(t *testing.T) {
@@ -143,7 +144,7 @@ func TestFieldNamesLowerSnakeCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, false, autodisable.Noop)
+			rule := rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -178,7 +179,7 @@ func TestFieldNamesLowerSnakeCaseRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, true, autodisable.Noop)
+			r := rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -213,7 +214,7 @@ func TestFieldNamesLowerSnakeCaseRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, true, test.inputPlacementType)
+			r := rules.NewFieldNamesLowerSnakeCaseRule(rule.SeverityError, true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
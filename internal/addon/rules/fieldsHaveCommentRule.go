@@ -14,16 +14,19 @@ type FieldsHaveCommentRule struct {
 	// Golang style comments should begin with the name of the thing being described.
 	// See https://github.com/golang/go/wiki/CodeReviewComments#comment-sentences
 	shouldFollowGolangStyle bool
+	env                     visitor.Env
 }
 
 // NewFieldsHaveCommentRule creates a new FieldsHaveCommentRule.
 func NewFieldsHaveCommentRule(
 	severity rule.Severity,
 	shouldFollowGolangStyle bool,
+	env visitor.Env,
 ) FieldsHaveCommentRule {
 	return FieldsHaveCommentRule{
 		RuleWithSeverity:        RuleWithSeverity{severity: severity},
 		shouldFollowGolangStyle: shouldFollowGolangStyle,
+		env:                     env,
 	}
 }
 
@@ -37,6 +40,20 @@ func (r FieldsHaveCommentRule) Purpose() string {
 	return "Verifies that all fields have a comment."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r FieldsHaveCommentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A comment on each field documents the meaning and the constraints of the value for the readers of the generated code.",
+		Bad: `message Foo {
+  string name = 1;
+}`,
+		Good: `message Foo {
+  // name is the display name of the foo.
+  string name = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r FieldsHaveCommentRule) IsOfficial() bool {
 	return false
@@ -45,7 +62,7 @@ func (r FieldsHaveCommentRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r FieldsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fieldsHaveCommentVisitor{
-		BaseAddVisitor:          visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor:          visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		shouldFollowGolangStyle: r.shouldFollowGolangStyle,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
@@ -60,9 +77,9 @@ type fieldsHaveCommentVisitor struct {
 func (v *fieldsHaveCommentVisitor) VisitField(field *parser.Field) bool {
 	n := field.FieldName
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(field.Comments, n) {
-		v.AddFailuref(field.Meta.Pos, `Field %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, n, `Field %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(field.Comments, field.InlineComment) {
-		v.AddFailuref(field.Meta.Pos, `Field %q should have a comment`, n)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, n, `Field %q should have a comment`, n)
 	}
 	return false
 }
@@ -71,9 +88,9 @@ func (v *fieldsHaveCommentVisitor) VisitField(field *parser.Field) bool {
 func (v *fieldsHaveCommentVisitor) VisitMapField(field *parser.MapField) bool {
 	n := field.MapName
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(field.Comments, n) {
-		v.AddFailuref(field.Meta.Pos, `Field %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, n, `Field %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(field.Comments, field.InlineComment) {
-		v.AddFailuref(field.Meta.Pos, `Field %q should have a comment`, n)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, n, `Field %q should have a comment`, n)
 	}
 	return false
 }
@@ -82,9 +99,9 @@ func (v *fieldsHaveCommentVisitor) VisitMapField(field *parser.MapField) bool {
 func (v *fieldsHaveCommentVisitor) VisitOneofField(field *parser.OneofField) bool {
 	n := field.FieldName
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(field.Comments, n) {
-		v.AddFailuref(field.Meta.Pos, `Field %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, n, `Field %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(field.Comments, field.InlineComment) {
-		v.AddFailuref(field.Meta.Pos, `Field %q should have a comment`, n)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, n, `Field %q should have a comment`, n)
 	}
 	return false
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestFieldsHaveCommentRule_Apply(t *testing.T) {
@@ -368,7 +369,7 @@ func TestFieldsHaveCommentRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewFieldsHaveCommentRule(rule.SeverityError, test.inputShouldFollowGolangStyle)
+			rule := rules.NewFieldsHaveCommentRule(rule.SeverityError, test.inputShouldFollowGolangStyle, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
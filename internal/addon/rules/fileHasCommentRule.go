@@ -29,6 +29,16 @@ func (r FileHasCommentRule) Purpose() string {
 	return "Verifies that a file starts with a doc comment."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r FileHasCommentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A comment at the top of the file tells what the file defines before the readers go through the definitions.",
+		Bad:       `syntax = "proto3";`,
+		Good: `// Defines the API of the song server.
+syntax = "proto3";`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r FileHasCommentRule) IsOfficial() bool {
 	return false
@@ -49,7 +59,7 @@ type fileHasCommentVisitor struct {
 // VisitSyntax checks the syntax.
 func (v *fileHasCommentVisitor) VisitSyntax(s *parser.Syntax) bool {
 	if !hasComment(s.Comments) {
-		v.AddFailuref(s.Meta.Pos, `File should start with a doc comment`)
+		v.AddFailureWithRangef(s.Meta.Pos, s.Meta.LastPos, `File should start with a doc comment`)
 	}
 	return false
 }
@@ -57,7 +67,7 @@ func (v *fileHasCommentVisitor) VisitSyntax(s *parser.Syntax) bool {
 // VisitEdition checks the syntax.
 func (v *fileHasCommentVisitor) VisitEdition(s *parser.Edition) bool {
 	if !hasComment(s.Comments) {
-		v.AddFailuref(s.Meta.Pos, `File should start with a doc comment`)
+		v.AddFailureWithRangef(s.Meta.Pos, s.Meta.LastPos, `File should start with a doc comment`)
 	}
 	return false
 }
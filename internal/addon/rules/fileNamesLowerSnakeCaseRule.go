@@ -1,7 +1,6 @@
 package rules
 
 import (
-	"os"
 	"path/filepath"
 	"strings"
 
@@ -21,6 +20,7 @@ type FileNamesLowerSnakeCaseRule struct {
 	RuleWithSeverity
 	excluded []string
 	fixMode  bool
+	env      visitor.Env
 }
 
 // NewFileNamesLowerSnakeCaseRule creates a new FileNamesLowerSnakeCaseRule.
@@ -28,11 +28,13 @@ func NewFileNamesLowerSnakeCaseRule(
 	severity rule.Severity,
 	excluded []string,
 	fixMode bool,
+	env visitor.Env,
 ) FileNamesLowerSnakeCaseRule {
 	return FileNamesLowerSnakeCaseRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		excluded:         excluded,
 		fixMode:          fixMode,
+		env:              env,
 	}
 }
 
@@ -46,17 +48,32 @@ func (r FileNamesLowerSnakeCaseRule) Purpose() string {
 	return "Verifies that all file names are lower_snake_case.proto."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r FileNamesLowerSnakeCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the files in lower_snake_case, which keeps the import paths and the generated file names consistent across the platforms.",
+		Bad:       `// File: SongServer.proto`,
+		Good:      `// File: song_server.proto`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r FileNamesLowerSnakeCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r FileNamesLowerSnakeCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r FileNamesLowerSnakeCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &fileNamesLowerSnakeCaseVisitor{
 		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
 		excluded:       r.excluded,
 		fixMode:        r.fixMode,
+		env:            r.env,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
 }
@@ -65,6 +82,7 @@ type fileNamesLowerSnakeCaseVisitor struct {
 	*visitor.BaseAddVisitor
 	excluded []string
 	fixMode  bool
+	env      visitor.Env
 }
 
 // Finally checks the file name and renames it if necessary.
@@ -85,14 +103,15 @@ func (v *fileNamesLowerSnakeCaseVisitor) Finally(proto *parser.Proto) error {
 		if v.fixMode {
 			dir := filepath.Dir(path)
 			newPath := filepath.Join(dir, expected)
-			if _, err := os.Stat(newPath); !os.IsNotExist(err) {
+			if v.env.Files.Exists(newPath) {
 				v.AddFailurefWithProtoMeta(proto.Meta, "Failed to rename %q because %q already exists.", filename, expected)
 				return nil
 			}
-			err := os.Rename(path, newPath)
+			err := v.env.Files.Rename(path, newPath)
 			if err != nil {
 				return err
 			}
+			v.env.FixCounter.AddRename()
 
 			// Notify the upstream this new filename by updating the proto.
 			proto.Meta.Filename = newPath
@@ -0,0 +1,33 @@
+package rules
+
+import (
+	"reflect"
+	"strings"
+)
+
+// sourceBaseURI is the base URI of the source files of the built-in rules.
+const sourceBaseURI = "https://github.com/maramkhaledn/protolint/blob/master/internal/addon/rules/"
+
+// HelpURI returns the URI of the source file of the built-in rule, whose doc comment describes the rule.
+// It returns an empty string if r is not a built-in rule, like a plugin rule.
+func HelpURI(r interface{}) string {
+	t := reflect.TypeOf(r)
+	if t == nil {
+		return ""
+	}
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.PkgPath() != reflect.TypeOf(RuleWithSeverity{}).PkgPath() || !strings.HasSuffix(t.Name(), "Rule") {
+		return ""
+	}
+	return sourceBaseURI + sourceFileName(t.Name())
+}
+
+// sourceFileName returns the file name of the rule type, like rpcsHaveCommentRule.go for RPCsHaveCommentRule.
+func sourceFileName(typeName string) string {
+	if strings.HasPrefix(typeName, "RPC") {
+		return "rpc" + strings.TrimPrefix(typeName, "RPC") + ".go"
+	}
+	return strings.ToLower(typeName[:1]) + typeName[1:] + ".go"
+}
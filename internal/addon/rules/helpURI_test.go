@@ -0,0 +1,36 @@
+package rules_test
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/addon/rules"
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+func TestHelpURI(t *testing.T) {
+	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, visitor.Env{}, false, nil)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	for _, r := range allRules {
+		r := r
+		t.Run(r.ID(), func(t *testing.T) {
+			got := rules.HelpURI(r)
+			fileName := got[strings.LastIndex(got, "/")+1:]
+			if _, err := os.Stat(fileName); err != nil {
+				t.Errorf("got %s, but the file doesn't exist: %v", got, err)
+			}
+		})
+	}
+
+	if got := rules.HelpURI(struct{}{}); got != "" {
+		t.Errorf("got %s, but want empty for the non built-in rule", got)
+	}
+}
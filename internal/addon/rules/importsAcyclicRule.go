@@ -0,0 +1,91 @@
+package rules
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// ImportsAcyclicRule verifies that the imports don't form a cycle.
+type ImportsAcyclicRule struct {
+	RuleWithSeverity
+}
+
+// NewImportsAcyclicRule creates a new ImportsAcyclicRule.
+func NewImportsAcyclicRule(severity rule.Severity) ImportsAcyclicRule {
+	return ImportsAcyclicRule{
+		RuleWithSeverity: RuleWithSeverity{severity: severity},
+	}
+}
+
+// ID returns the ID of this rule.
+func (r ImportsAcyclicRule) ID() string {
+	return "IMPORTS_ACYCLIC"
+}
+
+// Purpose returns the purpose of this rule.
+func (r ImportsAcyclicRule) Purpose() string {
+	return "Verifies that the imports don't form a cycle."
+}
+
+// Documentation returns the rationale and the examples of this rule.
+func (r ImportsAcyclicRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "protoc rejects the files importing each other, and its message doesn't tell the whole cycle. Moving the shared types into a file which both import breaks the cycle.",
+		Bad: `// a.proto
+import "b.proto";
+
+// b.proto
+import "a.proto";`,
+		Good: `// a.proto
+import "common.proto";
+
+// b.proto
+import "common.proto";`,
+	}
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r ImportsAcyclicRule) IsOfficial() bool {
+	return false
+}
+
+// Apply applies the rule to the proto, searching the default import paths for the imports.
+func (r ImportsAcyclicRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	return r.ApplyWithContext(symbol.NewImporter(symbol.DefaultImportPaths).Context(proto), proto)
+}
+
+// ApplyWithContext applies the rule to the proto with the import graph.
+func (r ImportsAcyclicRule) ApplyWithContext(ctx *symbol.Context, proto *parser.Proto) ([]report.Failure, error) {
+	v := &importsAcyclicVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		ctx:            ctx,
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type importsAcyclicVisitor struct {
+	*visitor.BaseAddVisitor
+	ctx *symbol.Context
+}
+
+// VisitImport checks whether the imported file leads back to the file.
+func (v *importsAcyclicVisitor) VisitImport(i *parser.Import) bool {
+	// The other files can't import the file outside the import paths.
+	if v.ctx.ImportPath == "" {
+		return false
+	}
+
+	path := v.ctx.Graph.Path(symbol.ImportPath(i), v.ctx.ImportPath)
+	if path == nil {
+		return false
+	}
+	cycle := append([]string{v.ctx.ImportPath}, path...)
+	v.AddFailureWithRangef(i.Meta.Pos, i.Meta.LastPos, "Imported file %s forms an import cycle: %s", i.Location, strings.Join(cycle, " -> "))
+	return false
+}
@@ -0,0 +1,117 @@
+package rules_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/addon/rules"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestImportsAcyclicRule_ApplyWithContext(t *testing.T) {
+	root := setting_test.TestDataPath("rules", "importsAcyclic")
+
+	tests := []struct {
+		name          string
+		inputFilename string
+		importPaths   []string
+		wantMessages  []string
+	}{
+		{
+			name:          "a failure for the import in the cycle",
+			inputFilename: "a.proto",
+			importPaths:   []string{root},
+			wantMessages: []string{
+				`Imported file "b.proto" forms an import cycle: a.proto -> b.proto -> c.proto -> a.proto`,
+			},
+		},
+		{
+			name:          "the cycle from another file in it",
+			inputFilename: "c.proto",
+			importPaths:   []string{root},
+			wantMessages: []string{
+				`Imported file "a.proto" forms an import cycle: c.proto -> a.proto -> b.proto -> c.proto`,
+			},
+		},
+		{
+			name:          "no failures for the file importing the cycle",
+			inputFilename: "d.proto",
+			importPaths:   []string{root},
+		},
+		{
+			name:          "a failure for the file importing itself",
+			inputFilename: "self.proto",
+			importPaths:   []string{root},
+			wantMessages: []string{
+				`Imported file "self.proto" forms an import cycle: self.proto -> self.proto`,
+			},
+		},
+		{
+			name:          "no failures for the file outside the import paths",
+			inputFilename: "a.proto",
+			importPaths:   []string{setting_test.TestDataPath("imports")},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(root, test.inputFilename)
+			proto, err := file.NewProtoFile(path, path).Parse(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rule := rules.NewImportsAcyclicRule(rule.SeverityError)
+			ctx := symbol.NewImporter(test.importPaths).Context(proto)
+
+			got, err := rule.ApplyWithContext(ctx, proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			var gotMessages []string
+			for _, f := range got {
+				gotMessages = append(gotMessages, f.Message())
+			}
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				t.Errorf("got %v, but want %v", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
+
+func TestImportsAcyclicRule_ApplyWithContext_position(t *testing.T) {
+	root := setting_test.TestDataPath("rules", "importsAcyclic")
+	path := filepath.Join(root, "b.proto")
+	proto, err := file.NewProtoFile(path, path).Parse(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := rules.NewImportsAcyclicRule(rule.SeverityError).ApplyWithContext(symbol.NewImporter([]string{root}).Context(proto), proto)
+	if err != nil {
+		t.Fatalf("got err %v, but want nil", err)
+	}
+
+	want := []report.Failure{
+		report.FailureWithRangef(
+			meta.Position{Filename: path, Offset: 36, Line: 5, Column: 1},
+			meta.Position{Filename: path, Offset: 52, Line: 5, Column: 17},
+			"IMPORTS_ACYCLIC",
+			string(rule.SeverityError),
+			`Imported file "c.proto" forms an import cycle: b.proto -> c.proto -> a.proto -> b.proto`,
+		),
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
@@ -14,16 +14,19 @@ import (
 type ImportsSortedRule struct {
 	RuleWithSeverity
 	fixMode bool
+	env     visitor.Env
 }
 
 // NewImportsSortedRule creates a new ImportsSortedRule.
 func NewImportsSortedRule(
 	severity rule.Severity,
 	fixMode bool,
+	env visitor.Env,
 ) ImportsSortedRule {
 	return ImportsSortedRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
+		env:              env,
 	}
 }
 
@@ -37,16 +40,32 @@ func (r ImportsSortedRule) Purpose() string {
 	return "Enforces sorted imports."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r ImportsSortedRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "Sorted imports make the diffs smaller and the duplicates easy to spot.",
+		Bad: `import "myproject/other_protos.proto";
+import "google/protobuf/empty.proto";`,
+		Good: `import "google/protobuf/empty.proto";
+import "myproject/other_protos.proto";`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r ImportsSortedRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r ImportsSortedRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r ImportsSortedRule) Apply(
 	proto *parser.Proto,
 ) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), true, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), true, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -78,8 +97,9 @@ func (v importsSortedVisitor) Finally(proto *parser.Proto) error {
 
 		for i, line := range lines {
 			if invalid, ok := notSorted[i+1]; ok {
-				v.AddFailuref(
+				v.AddFailureWithRangef(
 					invalid.Meta.Pos,
+					invalid.Meta.LastPos,
 					`Imports are not sorted.`,
 				)
 				line = lines[invalid.sortedLine-1]
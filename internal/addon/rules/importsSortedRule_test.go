@@ -14,6 +14,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func testImportSortedProtoPath(name string) string {
@@ -39,24 +40,36 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 			name:          "failures for proto with not sorted imports",
 			inputFilename: "notSorted.proto",
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSorted.proto"),
 						Offset:   20,
 						Line:     3,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: testImportSortedProtoPath("notSorted.proto"),
+						Offset:   45,
+						Line:     3,
+						Column:   26,
+					},
 					"IMPORTS_SORTED",
 					string(rule.SeverityError),
 					`Imports are not sorted.`,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSorted.proto"),
 						Offset:   47,
 						Line:     4,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: testImportSortedProtoPath("notSorted.proto"),
+						Offset:   84,
+						Line:     4,
+						Column:   38,
+					},
 					"IMPORTS_SORTED",
 					string(rule.SeverityError),
 					`Imports are not sorted.`,
@@ -67,46 +80,70 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 			name:          "failures for proto with not sorted imports separated by a newline",
 			inputFilename: "notSortedWithNewline.proto",
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
 						Offset:   20,
 						Line:     3,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   40,
+						Line:     3,
+						Column:   21,
+					},
 					"IMPORTS_SORTED",
 					string(rule.SeverityError),
 					`Imports are not sorted.`,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
 						Offset:   42,
 						Line:     4,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   67,
+						Line:     4,
+						Column:   26,
+					},
 					"IMPORTS_SORTED",
 					string(rule.SeverityError),
 					`Imports are not sorted.`,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
 						Offset:   151,
 						Line:     9,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   188,
+						Line:     9,
+						Column:   38,
+					},
 					"IMPORTS_SORTED",
 					string(rule.SeverityError),
 					`Imports are not sorted.`,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
 						Offset:   190,
 						Line:     10,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: testImportSortedProtoPath("notSortedWithNewline.proto"),
+						Offset:   226,
+						Line:     10,
+						Column:   37,
+					},
 					"IMPORTS_SORTED",
 					string(rule.SeverityError),
 					`Imports are not sorted.`,
@@ -121,6 +158,7 @@ func TestImportsSortedRule_Apply(t *testing.T) {
 			rule := rules.NewImportsSortedRule(
 				rule.SeverityError,
 				false,
+				visitor.Env{},
 			)
 
 			protoPath := testImportSortedProtoPath(test.inputFilename)
@@ -189,6 +227,7 @@ func TestImportsSortedRule_Apply_fix(t *testing.T) {
 			rule := rules.NewImportsSortedRule(
 				rule.SeverityError,
 				true,
+				visitor.Env{},
 			)
 
 			input, err := newTestImportsSortedData(test.inputFilename)
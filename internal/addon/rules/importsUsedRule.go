@@ -0,0 +1,234 @@
+package rules
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// ImportsUsedRule verifies that all imported files are used and imported only once.
+type ImportsUsedRule struct {
+	RuleWithSeverity
+	fixMode bool
+	env     visitor.Env
+}
+
+// NewImportsUsedRule creates a new ImportsUsedRule.
+func NewImportsUsedRule(
+	severity rule.Severity,
+	fixMode bool,
+	env visitor.Env,
+) ImportsUsedRule {
+	return ImportsUsedRule{
+		RuleWithSeverity: RuleWithSeverity{severity: severity},
+		fixMode:          fixMode,
+		env:              env,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r ImportsUsedRule) ID() string {
+	return "IMPORTS_USED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r ImportsUsedRule) Purpose() string {
+	return "Verifies that all imported files are used and imported only once."
+}
+
+// Documentation returns the rationale and the examples of this rule.
+func (r ImportsUsedRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "An unused import makes protoc warn and ties the file to a dependency it doesn't need. The public imports are considered used because the importers of the file may use them.",
+		Bad: `import "google/protobuf/empty.proto";
+import "google/protobuf/timestamp.proto";
+
+message Song {
+  google.protobuf.Timestamp create_time = 1;
+}`,
+		Good: `import "google/protobuf/timestamp.proto";
+
+message Song {
+  google.protobuf.Timestamp create_time = 1;
+}`,
+	}
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r ImportsUsedRule) IsOfficial() bool {
+	return false
+}
+
+// IsFixable decides whether or not this rule can fix the failures.
+func (r ImportsUsedRule) IsFixable() bool {
+	return true
+}
+
+// Apply applies the rule to the proto, searching the default import paths for the imports.
+func (r ImportsUsedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	return r.ApplyWithContext(symbol.NewImporter(symbol.DefaultImportPaths).Context(proto), proto)
+}
+
+// ApplyWithContext applies the rule to the proto with the resolved imports.
+func (r ImportsUsedRule) ApplyWithContext(ctx *symbol.Context, proto *parser.Proto) ([]report.Failure, error) {
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
+	if err != nil {
+		return nil, err
+	}
+
+	v := &importsUsedVisitor{
+		BaseFixableVisitor: base,
+		unused:             unusedImports(ctx, proto),
+		imported:           make(map[string]bool),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+// unusedImports returns the resolved imports which neither the types nor the custom options in the proto use.
+func unusedImports(ctx *symbol.Context, proto *parser.Proto) map[*parser.Import]bool {
+	usedFiles := make(map[string]bool)
+	for _, ref := range symbol.References(proto) {
+		if def, ok := ctx.Table.Resolve(ref.Scope, ref.Name); ok {
+			usedFiles[def.Pos.Filename] = true
+		}
+	}
+	for _, ref := range symbol.OptionReferences(proto) {
+		if def, ok := ctx.Extensions.Resolve(ref.Scope, ref.Name); ok {
+			usedFiles[def.Pos.Filename] = true
+		}
+	}
+
+	unused := make(map[*parser.Import]bool)
+	for _, imp := range ctx.Imports {
+		if imp.Err != nil || imp.Import.Modifier == parser.ImportModifierPublic {
+			continue
+		}
+
+		used := false
+		// The file is also used through the files which it imports publicly.
+		for _, f := range ctx.Importer.PublicClosure(symbol.ImportPath(imp.Import), imp.File) {
+			used = used || usedFiles[f.Path]
+		}
+		if !used {
+			unused[imp.Import] = true
+		}
+	}
+	return unused
+}
+
+type importsUsedVisitor struct {
+	*visitor.BaseFixableVisitor
+	unused   map[*parser.Import]bool
+	imported map[string]bool
+	removed  []importSpan
+}
+
+// importSpan is the range of the content from start up to, but not including, end.
+type importSpan struct {
+	start int
+	end   int
+}
+
+// VisitImport checks the import.
+func (v *importsUsedVisitor) VisitImport(i *parser.Import) bool {
+	path := symbol.ImportPath(i)
+	switch {
+	case v.imported[path]:
+		v.AddFailureWithRangef(i.Meta.Pos, i.Meta.LastPos, "Imported file %s is imported more than once", i.Location)
+	case v.unused[i]:
+		v.AddFailureWithRangef(i.Meta.Pos, i.Meta.LastPos, "Imported file %s is not used", i.Location)
+	default:
+		v.imported[path] = true
+		return false
+	}
+	v.imported[path] = true
+
+	// LastPos is the position of the semicolon.
+	v.removed = append(v.removed, importSpan{start: i.Meta.Pos.Offset, end: i.Meta.LastPos.Offset + 1})
+	return false
+}
+
+// Finally removes the reported imports.
+// Removing them keeps the order of the rest, so that the imports sorted stay sorted.
+func (v *importsUsedVisitor) Finally(proto *parser.Proto) error {
+	if 0 < len(v.removed) {
+		v.Fixer.ReplaceContent(func(content []byte) []byte {
+			return removeSpans(content, v.removed)
+		})
+	}
+	return v.BaseFixableVisitor.Finally(proto)
+}
+
+// removeSpans removes the spans from the content.
+// The line which becomes blank is removed with its line ending, and the other statements on the line are kept.
+func removeSpans(content []byte, spans []importSpan) []byte {
+	removed := make([]bool, len(content))
+	for _, span := range spans {
+		start, end := span.start, span.end
+		if start < 0 || end < start || len(content) < end {
+			continue
+		}
+		// Remove the spaces separating the span from the next statement on the line,
+		for end < len(content) && isHorizontalSpace(content[end]) {
+			end++
+		}
+		// or from the previous one when nothing follows.
+		if end == len(content) || content[end] == '\r' || content[end] == '\n' {
+			for 0 < start && isHorizontalSpace(content[start-1]) {
+				start--
+			}
+		}
+		for i := start; i < end; i++ {
+			removed[i] = true
+		}
+	}
+
+	var fixed []byte
+	lastFixedBlank := false
+	prevRemoved := false
+	for start := 0; start < len(content); {
+		end := len(content)
+		if i := bytes.IndexByte(content[start:], '\n'); 0 <= i {
+			end = start + i + 1
+		}
+
+		var line []byte
+		touched := false
+		for i := start; i < end; i++ {
+			if removed[i] {
+				touched = true
+				continue
+			}
+			line = append(line, content[i])
+		}
+		start = end
+
+		blank := isBlank(string(line))
+		switch {
+		case touched && blank:
+			prevRemoved = true
+			continue
+		case blank && prevRemoved && lastFixedBlank:
+			// Collapse the blank lines left around the removed imports.
+		default:
+			fixed = append(fixed, line...)
+			lastFixedBlank = blank
+		}
+		prevRemoved = false
+	}
+	return fixed
+}
+
+func isHorizontalSpace(b byte) bool {
+	return b == ' ' || b == '\t'
+}
+
+func isBlank(line string) bool {
+	return strings.TrimSpace(line) == ""
+}
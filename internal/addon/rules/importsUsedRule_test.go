@@ -0,0 +1,175 @@
+package rules_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/addon/rules"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+	"github.com/maramkhaledn/protolint/internal/util_test"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+func TestImportsUsedRule_ApplyWithContext(t *testing.T) {
+	root := setting_test.TestDataPath("rules", "importsUsed")
+	unusedPath := filepath.Join(root, "unused.proto")
+	onelinePath := filepath.Join(root, "oneline.proto")
+
+	tests := []struct {
+		name          string
+		inputFilename string
+		wantFailures  []report.Failure
+	}{
+		{
+			name:          "no failures for the used imports",
+			inputFilename: "used.proto",
+		},
+		{
+			name:          "failures for the unused and duplicated imports",
+			inputFilename: "unused.proto",
+			wantFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{Filename: unusedPath, Offset: 38, Line: 5, Column: 1},
+					meta.Position{Filename: unusedPath, Offset: 72, Line: 5, Column: 35},
+					"IMPORTS_USED",
+					string(rule.SeverityError),
+					`Imported file "google/protobuf/any.proto" is not used`,
+				),
+				report.FailureWithRangef(
+					meta.Position{Filename: unusedPath, Offset: 110, Line: 7, Column: 1},
+					meta.Position{Filename: unusedPath, Offset: 149, Line: 7, Column: 40},
+					"IMPORTS_USED",
+					string(rule.SeverityError),
+					`Imported file "google/protobuf/duration.proto" is not used`,
+				),
+				report.FailureWithRangef(
+					meta.Position{Filename: unusedPath, Offset: 189, Line: 9, Column: 1},
+					meta.Position{Filename: unusedPath, Offset: 225, Line: 9, Column: 37},
+					"IMPORTS_USED",
+					string(rule.SeverityError),
+					`Imported file "google/protobuf/empty.proto" is imported more than once`,
+				),
+				report.FailureWithRangef(
+					meta.Position{Filename: unusedPath, Offset: 353, Line: 14, Column: 1},
+					meta.Position{Filename: unusedPath, Offset: 390, Line: 14, Column: 38},
+					"IMPORTS_USED",
+					string(rule.SeverityError),
+					`Imported file "google/protobuf/struct.proto" is not used`,
+				),
+			},
+		},
+		{
+			name:          "a failure for the unused import on the line with another import",
+			inputFilename: "oneline.proto",
+			wantFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{Filename: onelinePath, Offset: 38, Line: 5, Column: 1},
+					meta.Position{Filename: onelinePath, Offset: 54, Line: 5, Column: 17},
+					"IMPORTS_USED",
+					string(rule.SeverityError),
+					`Imported file "a.proto" is not used`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(root, test.inputFilename)
+			proto, err := file.NewProtoFile(path, path).Parse(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rule := rules.NewImportsUsedRule(rule.SeverityError, false, visitor.Env{})
+			ctx := symbol.NewImporter([]string{root}).Context(proto)
+
+			got, err := rule.ApplyWithContext(ctx, proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
+
+func TestImportsUsedRule_ApplyWithContext_fix(t *testing.T) {
+	root := setting_test.TestDataPath("rules", "importsUsed")
+
+	tests := []struct {
+		name          string
+		inputFilename string
+		wantFilename  string
+	}{
+		{
+			name:          "removes the lines of the unused and duplicated imports",
+			inputFilename: "unused.proto",
+			wantFilename:  "used.proto",
+		},
+		{
+			name:          "removes only the unused import on the line with another import",
+			inputFilename: "oneline.proto",
+			wantFilename:  "oneline_used.proto",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			input, err := util_test.NewTestData(filepath.Join(root, test.inputFilename))
+			if err != nil {
+				t.Fatal(err)
+			}
+			want, err := util_test.NewTestData(filepath.Join(root, test.wantFilename))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer func() {
+				if err := input.Restore(); err != nil {
+					t.Errorf("got err %v", err)
+				}
+			}()
+
+			proto, err := file.NewProtoFile(input.FilePath, input.FilePath).Parse(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			r := rules.NewImportsUsedRule(rule.SeverityError, true, visitor.Env{})
+			if _, err := r.ApplyWithContext(symbol.NewImporter([]string{root}).Context(proto), proto); err != nil {
+				t.Fatalf("got err %v, but want nil", err)
+			}
+
+			got, err := input.Data()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, want.OriginData) {
+				t.Errorf("got %s, but want %s", got, want.OriginData)
+			}
+
+			// The imports left by the fix stay sorted.
+			fixed, err := file.NewProtoFile(input.FilePath, input.FilePath).Parse(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			failures, err := rules.NewImportsSortedRule(rule.SeverityError, false, visitor.Env{}).Apply(fixed)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(failures) != 0 {
+				t.Errorf("got %v, but want no failures", failures)
+			}
+		})
+	}
+}
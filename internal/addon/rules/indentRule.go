@@ -24,6 +24,7 @@ type IndentRule struct {
 	style            string
 	notInsertNewline bool
 	fixMode          bool
+	env              visitor.Env
 }
 
 // NewIndentRule creates a new IndentRule.
@@ -32,6 +33,7 @@ func NewIndentRule(
 	style string,
 	notInsertNewline bool,
 	fixMode bool,
+	env visitor.Env,
 ) IndentRule {
 	if len(style) == 0 {
 		style = defaultStyle
@@ -42,6 +44,7 @@ func NewIndentRule(
 		style:            style,
 		notInsertNewline: notInsertNewline,
 		fixMode:          fixMode,
+		env:              env,
 	}
 }
 
@@ -55,24 +58,45 @@ func (r IndentRule) Purpose() string {
 	return "Enforces a consistent indentation style."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r IndentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A consistent indentation keeps the nested definitions readable and the diffs free from the whitespace changes.",
+		Bad: `message Foo {
+    string name = 1;
+}`,
+		Good: `message Foo {
+  string name = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r IndentRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r IndentRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r IndentRule) Apply(
 	proto *parser.Proto,
 ) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), true, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), true, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
 
+	// The fixer records the fixes without writing the file if they are recorded.
+	fixMode := r.fixMode || r.env.FixRecorder != nil
+
 	v := &indentVisitor{
 		BaseFixableVisitor: base,
 		style:              r.style,
-		fixMode:            r.fixMode,
+		fixMode:            fixMode,
 		notInsertNewline:   r.notInsertNewline,
 		indentFixes:        make(map[int][]indentFix),
 	}
@@ -337,14 +361,16 @@ func (v indentVisitor) validateIndent(
 		return
 	}
 	if len(v.indentFixes[pos.Line-1]) == 1 {
-		v.AddFailuref(
+		v.AddFailureWithRangef(
+			pos,
 			pos,
 			`Found an incorrect indentation style "%s". "%s" is correct.`,
 			leading,
 			indentation,
 		)
 	} else {
-		v.AddFailuref(
+		v.AddFailureWithRangef(
+			pos,
 			pos,
 			`Found a possible incorrect indentation style. Inserting a new line is recommended.`,
 		)
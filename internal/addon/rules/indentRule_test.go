@@ -16,6 +16,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestIndentRule_Apply(t *testing.T) {
@@ -38,7 +39,13 @@ func TestIndentRule_Apply(t *testing.T) {
 			inputStyle:     defaultSpace,
 			inputProtoPath: setting_test.TestDataPath("rules", "indentrule", "incorrect_syntax.proto"),
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_syntax.proto"),
+						Offset:   14,
+						Line:     2,
+						Column:   5,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_syntax.proto"),
 						Offset:   14,
@@ -61,7 +68,13 @@ func TestIndentRule_Apply(t *testing.T) {
 			name:           "incorrect enum",
 			inputProtoPath: setting_test.TestDataPath("rules", "indentrule", "incorrect_enum.proto"),
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_enum.proto"),
+						Offset:   67,
+						Line:     4,
+						Column:   9,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_enum.proto"),
 						Offset:   67,
@@ -74,7 +87,13 @@ func TestIndentRule_Apply(t *testing.T) {
 					"        ",
 					defaultSpace,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_enum.proto"),
+						Offset:   114,
+						Line:     6,
+						Column:   6,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_enum.proto"),
 						Offset:   114,
@@ -87,7 +106,13 @@ func TestIndentRule_Apply(t *testing.T) {
 					"     ",
 					defaultSpace,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_enum.proto"),
+						Offset:   162,
+						Line:     7,
+						Column:   2,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_enum.proto"),
 						Offset:   162,
@@ -110,7 +135,13 @@ func TestIndentRule_Apply(t *testing.T) {
 			name:           "incorrect message",
 			inputProtoPath: setting_test.TestDataPath("rules", "indentrule", "incorrect_message.proto"),
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_message.proto"),
+						Offset:   100,
+						Line:     6,
+						Column:   3,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_message.proto"),
 						Offset:   100,
@@ -123,7 +154,13 @@ func TestIndentRule_Apply(t *testing.T) {
 					"  ",
 					strings.Repeat(defaultSpace, 2),
 				),
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_message.proto"),
+						Offset:   156,
+						Line:     9,
+						Column:   1,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_message.proto"),
 						Offset:   156,
@@ -136,7 +173,13 @@ func TestIndentRule_Apply(t *testing.T) {
 					"",
 					defaultSpace,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_message.proto"),
+						Offset:   287,
+						Line:     14,
+						Column:   7,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_message.proto"),
 						Offset:   287,
@@ -170,7 +213,13 @@ Fix https://github.com/maramkhaledn/protolint/issues/139`,
 Fix https://github.com/maramkhaledn/protolint/issues/139`,
 			inputProtoPath: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139.proto"),
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139.proto"),
+						Offset:   222,
+						Line:     11,
+						Column:   3,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139.proto"),
 						Offset:   222,
@@ -191,7 +240,13 @@ Fix https://github.com/maramkhaledn/protolint/issues/139`,
 			inputProtoPath:     setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139_short.proto"),
 			inputInsertNewline: true,
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139_short.proto"),
+						Offset:   82,
+						Line:     7,
+						Column:   3,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139_short.proto"),
 						Offset:   82,
@@ -204,7 +259,13 @@ Fix https://github.com/maramkhaledn/protolint/issues/139`,
 					"  ",
 					"",
 				),
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139_short.proto"),
+						Offset:   104,
+						Line:     7,
+						Column:   25,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139_short.proto"),
 						Offset:   104,
@@ -215,7 +276,13 @@ Fix https://github.com/maramkhaledn/protolint/issues/139`,
 					string(rule.SeverityError),
 					`Found a possible incorrect indentation style. Inserting a new line is recommended.`,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139_short.proto"),
+						Offset:   127,
+						Line:     7,
+						Column:   48,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "incorrect_issue_139_short.proto"),
 						Offset:   127,
@@ -233,7 +300,13 @@ Fix https://github.com/maramkhaledn/protolint/issues/139`,
 Fix https://github.com/maramkhaledn/protolint/issues/280`,
 			inputProtoPath: setting_test.TestDataPath("rules", "indentrule", "issue_280_mix_lineending.proto"),
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "indentrule", "issue_280_mix_lineending.proto"),
+						Offset:   580,
+						Line:     27,
+						Column:   5,
+					},
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "indentrule", "issue_280_mix_lineending.proto"),
 						Offset:   580,
@@ -258,6 +331,7 @@ Fix https://github.com/maramkhaledn/protolint/issues/280`,
 				test.inputStyle,
 				!test.inputInsertNewline,
 				false,
+				visitor.Env{},
 			)
 
 			proto, err := file.NewProtoFile(test.inputProtoPath, test.inputProtoPath).Parse(false)
@@ -445,6 +519,7 @@ func TestIndentRule_Apply_fix(t *testing.T) {
 				space2,
 				!test.inputInsertNewline,
 				true,
+				visitor.Env{},
 			)
 
 			proto, err := file.NewProtoFile(test.inputTestData.FilePath, test.inputTestData.FilePath).Parse(false)
@@ -482,6 +557,7 @@ func TestIndentRule_Apply_fix(t *testing.T) {
 				space2,
 				!test.inputInsertNewline,
 				false,
+				visitor.Env{},
 			)
 			proto, err = file.NewProtoFile(test.inputTestData.FilePath, test.inputTestData.FilePath).Parse(false)
 			if err != nil {
@@ -2,7 +2,6 @@ package rules
 
 import (
 	"bufio"
-	"os"
 	"strings"
 	"unicode/utf8"
 
@@ -12,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/disablerule"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 const (
@@ -28,6 +28,7 @@ type MaxLineLengthRule struct {
 	RuleWithSeverity
 	maxChars int
 	tabChars int
+	env      visitor.Env
 }
 
 // NewMaxLineLengthRule creates a new MaxLineLengthRule.
@@ -35,6 +36,7 @@ func NewMaxLineLengthRule(
 	severity rule.Severity,
 	maxChars int,
 	tabChars int,
+	env visitor.Env,
 ) MaxLineLengthRule {
 	if maxChars == 0 {
 		maxChars = defaultMaxChars
@@ -46,6 +48,7 @@ func NewMaxLineLengthRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		maxChars:         maxChars,
 		tabChars:         tabChars,
+		env:              env,
 	}
 }
 
@@ -59,6 +62,19 @@ func (r MaxLineLengthRule) Purpose() string {
 	return "Enforces a maximum line length."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r MaxLineLengthRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "Short lines are easier to read and to review side by side.",
+		Bad: `message Foo {
+  string a_very_long_field_name_which_goes_on_and_on_beyond_the_limit_of_eighty_characters = 1;
+}`,
+		Good: `message Foo {
+  string short_field_name = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r MaxLineLengthRule) IsOfficial() bool {
 	return true
@@ -70,7 +86,7 @@ func (r MaxLineLengthRule) Apply(proto *parser.Proto) (
 	err error,
 ) {
 	fileName := proto.Meta.Filename
-	reader, err := os.Open(fileName)
+	reader, err := r.env.Files.Open(fileName)
 	if err != nil {
 		return nil, err
 	}
@@ -97,15 +113,21 @@ func (r MaxLineLengthRule) Apply(proto *parser.Proto) (
 	disablerule.NewInterpreter(r.ID()).CallEachIfValid(
 		lines,
 		func(index int, line string) {
+			lastColumn := utf8.RuneCountInString(line)
 			line = strings.Replace(line, "\t", strings.Repeat(" ", r.tabChars), -1)
 			lineCount := utf8.RuneCountInString(line)
 			if r.maxChars < lineCount {
-				failures = append(failures, report.Failuref(
+				failures = append(failures, report.FailureWithRangef(
 					meta.Position{
 						Filename: fileName,
 						Line:     index + 1,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: fileName,
+						Line:     index + 1,
+						Column:   lastColumn,
+					},
 					r.ID(),
 					string(r.Severity()),
 					"The line length is %d, but it must be shorter than %d",
@@ -13,6 +13,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestMaxLineLengthRule_Apply(t *testing.T) {
@@ -54,22 +55,32 @@ func TestMaxLineLengthRule_Apply(t *testing.T) {
 				},
 			},
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
 						Line:     3,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+						Line:     3,
+						Column:   91,
+					},
 					"MAX_LINE_LENGTH",
 					string(rule.SeverityError),
 					`The line length is 91, but it must be shorter than 80`,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
 						Line:     15,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+						Line:     15,
+						Column:   88,
+					},
 					"MAX_LINE_LENGTH",
 					string(rule.SeverityError),
 					`The line length is 88, but it must be shorter than 80`,
@@ -86,22 +97,32 @@ func TestMaxLineLengthRule_Apply(t *testing.T) {
 				},
 			},
 			wantFailures: []report.Failure{
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
 						Line:     3,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+						Line:     3,
+						Column:   91,
+					},
 					"MAX_LINE_LENGTH",
 					"warning",
 					`The line length is 91, but it must be shorter than 80`,
 				),
-				report.Failuref(
+				report.FailureWithRangef(
 					meta.Position{
 						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
 						Line:     15,
 						Column:   1,
 					},
+					meta.Position{
+						Filename: setting_test.TestDataPath("rules", "max_line_length_rule.proto"),
+						Line:     15,
+						Column:   88,
+					},
 					"MAX_LINE_LENGTH",
 					"warning",
 					`The line length is 88, but it must be shorter than 80`,
@@ -117,6 +138,7 @@ func TestMaxLineLengthRule_Apply(t *testing.T) {
 				test.severity,
 				test.inputMaxChars,
 				test.inputTabChars,
+				visitor.Env{},
 			)
 
 			got, err := rule.Apply(test.inputProto)
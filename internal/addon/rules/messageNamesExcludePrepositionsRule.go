@@ -19,6 +19,7 @@ type MessageNamesExcludePrepositionsRule struct {
 	RuleWithSeverity
 	prepositions []string
 	excludes     []string
+	env          visitor.Env
 }
 
 // NewMessageNamesExcludePrepositionsRule creates a new MessageNamesExcludePrepositionsRule.
@@ -26,6 +27,7 @@ func NewMessageNamesExcludePrepositionsRule(
 	severity rule.Severity,
 	prepositions []string,
 	excludes []string,
+	env visitor.Env,
 ) MessageNamesExcludePrepositionsRule {
 	if len(prepositions) == 0 {
 		for _, p := range defaultPrepositions {
@@ -36,6 +38,7 @@ func NewMessageNamesExcludePrepositionsRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		prepositions:     prepositions,
 		excludes:         excludes,
+		env:              env,
 	}
 }
 
@@ -54,10 +57,22 @@ func (r MessageNamesExcludePrepositionsRule) Purpose() string {
 	return `Verifies that all message names don't include prepositions (e.g. "With", "For").`
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r MessageNamesExcludePrepositionsRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The API design guide keeps prepositions out of the message names, because they often hide a concept which deserves its own message.",
+		Bad: `message BookWithAuthor {
+}`,
+		Good: `message Book {
+  Author author = 1;
+}`,
+	}
+}
+
 // Apply applies the rule to the proto.
 func (r MessageNamesExcludePrepositionsRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &messageNamesExcludePrepositionsVisitor{
-		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor: visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		prepositions:   r.prepositions,
 		excludes:       r.excludes,
 	}
@@ -80,7 +95,7 @@ func (v *messageNamesExcludePrepositionsVisitor) VisitMessage(message *parser.Me
 	parts := strs.SplitCamelCaseWord(name)
 	for _, p := range parts {
 		if stringsutil.ContainsStringInSlice(p, v.prepositions) {
-			v.AddFailuref(message.Meta.Pos, "Message name %q should not include a preposition %q", message.MessageName, p)
+			v.AddFailureAtNamef(message.Meta.Pos, message.Meta.LastPos, message.MessageName, "Message name %q should not include a preposition %q", message.MessageName, p)
 		}
 	}
 	return true
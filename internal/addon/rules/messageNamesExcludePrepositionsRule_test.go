@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestMessageNamesExcludePrepositionsRule_Apply(t *testing.T) {
@@ -164,7 +165,7 @@ func TestMessageNamesExcludePrepositionsRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewMessageNamesExcludePrepositionsRule(rule.SeverityError, test.inputPrepositions, test.inputExcludes)
+			rule := rules.NewMessageNamesExcludePrepositionsRule(rule.SeverityError, test.inputPrepositions, test.inputExcludes, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
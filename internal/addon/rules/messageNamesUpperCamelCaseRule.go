@@ -18,6 +18,7 @@ type MessageNamesUpperCamelCaseRule struct {
 	RuleWithSeverity
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewMessageNamesUpperCamelCaseRule creates a new MessageNamesUpperCamelCaseRule.
@@ -25,6 +26,7 @@ func NewMessageNamesUpperCamelCaseRule(
 	severity rule.Severity,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) MessageNamesUpperCamelCaseRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -33,6 +35,7 @@ func NewMessageNamesUpperCamelCaseRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -46,14 +49,30 @@ func (r MessageNamesUpperCamelCaseRule) Purpose() string {
 	return "Verifies that all message names are CamelCase (with an initial capital)."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r MessageNamesUpperCamelCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the messages in CamelCase, which fits the type names in the generated code.",
+		Bad: `message song_server_request {
+}`,
+		Good: `message SongServerRequest {
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r MessageNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r MessageNamesUpperCamelCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r MessageNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +92,7 @@ func (v *messageNamesUpperCamelCaseVisitor) VisitMessage(message *parser.Message
 	name := message.MessageName
 	if !strs.IsUpperCamelCase(name) {
 		expected := strs.ToUpperCamelCase(name)
-		v.AddFailuref(message.Meta.Pos, "Message name %q must be UpperCamelCase like %q", name, expected)
+		v.AddFailureAtNamef(message.Meta.Pos, message.Meta.LastPos, name, "Message name %q must be UpperCamelCase like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(message.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestMessageNamesUpperCamelCaseRule_Apply(t *testing.T) {
@@ -129,7 +130,7 @@ func TestMessageNamesUpperCamelCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewMessageNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop)
+			rule := rules.NewMessageNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -164,7 +165,7 @@ func TestMessageNamesUpperCamelCaseRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewMessageNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop)
+			r := rules.NewMessageNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -199,7 +200,7 @@ func TestMessageNamesUpperCamelCaseRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewMessageNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType)
+			r := rules.NewMessageNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -17,16 +17,19 @@ type MessagesHaveCommentRule struct {
 	// Golang style comments should begin with the name of the thing being described.
 	// See https://github.com/golang/go/wiki/CodeReviewComments#comment-sentences
 	shouldFollowGolangStyle bool
+	env                     visitor.Env
 }
 
 // NewMessagesHaveCommentRule creates a new MessagesHaveCommentRule.
 func NewMessagesHaveCommentRule(
 	severity rule.Severity,
 	shouldFollowGolangStyle bool,
+	env visitor.Env,
 ) MessagesHaveCommentRule {
 	return MessagesHaveCommentRule{
 		RuleWithSeverity:        RuleWithSeverity{severity: severity},
 		shouldFollowGolangStyle: shouldFollowGolangStyle,
+		env:                     env,
 	}
 }
 
@@ -40,6 +43,18 @@ func (r MessagesHaveCommentRule) Purpose() string {
 	return "Verifies that all messages have a comment."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r MessagesHaveCommentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A comment on each message documents the type for the readers of the generated code.",
+		Bad: `message Foo {
+}`,
+		Good: `// Foo is a request to the song server.
+message Foo {
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r MessagesHaveCommentRule) IsOfficial() bool {
 	return false
@@ -48,7 +63,7 @@ func (r MessagesHaveCommentRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r MessagesHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &messagesHaveCommentVisitor{
-		BaseAddVisitor:          visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor:          visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		shouldFollowGolangStyle: r.shouldFollowGolangStyle,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
@@ -63,9 +78,9 @@ type messagesHaveCommentVisitor struct {
 func (v *messagesHaveCommentVisitor) VisitMessage(message *parser.Message) bool {
 	n := message.MessageName
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(message.Comments, n) {
-		v.AddFailuref(message.Meta.Pos, `Message %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(message.Meta.Pos, message.Meta.LastPos, n, `Message %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(message.Comments, message.InlineComment, message.InlineCommentBehindLeftCurly) {
-		v.AddFailuref(message.Meta.Pos, `Message %q should have a comment`, n)
+		v.AddFailureAtNamef(message.Meta.Pos, message.Meta.LastPos, n, `Message %q should have a comment`, n)
 	}
 	return true
 }
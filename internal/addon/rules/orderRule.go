@@ -20,16 +20,19 @@ import (
 type OrderRule struct {
 	RuleWithSeverity
 	fixMode bool
+	env     visitor.Env
 }
 
 // NewOrderRule creates a new OrderRule.
 func NewOrderRule(
 	severity rule.Severity,
 	fixMode bool,
+	env visitor.Env,
 ) OrderRule {
 	return OrderRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
+		env:              env,
 	}
 }
 
@@ -43,14 +46,34 @@ func (r OrderRule) Purpose() string {
 	return "Verifies that all files should be ordered in the specific manner."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r OrderRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A fixed order of the syntax, the package, the imports, the options and the rest lets the readers find each part of the file in the same place.",
+		Bad: `option java_package = "com.example.foo";
+syntax = "proto3";
+package examplePb;
+import "other.proto";`,
+		Good: `syntax = "proto3";
+package examplePb;
+import "other.proto";
+option java_package = "com.example.foo";`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r OrderRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r OrderRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r OrderRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +116,7 @@ func (v *orderVisitor) Finally(proto *parser.Proto) error {
 func (v *orderVisitor) VisitSyntax(s *parser.Syntax) bool {
 	next := v.machine.transit(v.state, syntaxVisitEvent)
 	if next == invalidOrderState {
-		v.AddFailuref(s.Meta.Pos, "Syntax should be located at the top. Check if the file is ordered in the correct manner.")
+		v.AddFailureWithRangef(s.Meta.Pos, s.Meta.LastPos, "Syntax should be located at the top. Check if the file is ordered in the correct manner.")
 	}
 	v.state = syntaxOrderState
 	v.formatter.syntax = s
@@ -103,7 +126,7 @@ func (v *orderVisitor) VisitSyntax(s *parser.Syntax) bool {
 func (v *orderVisitor) VisitEdition(e *parser.Edition) bool {
 	next := v.machine.transit(v.state, syntaxVisitEvent)
 	if next == invalidOrderState {
-		v.AddFailuref(e.Meta.Pos, "Edition should be located at the top. Check if the file is ordered in the correct manner.")
+		v.AddFailureWithRangef(e.Meta.Pos, e.Meta.LastPos, "Edition should be located at the top. Check if the file is ordered in the correct manner.")
 	}
 	v.state = syntaxOrderState
 	v.formatter.edition = e
@@ -113,7 +136,7 @@ func (v *orderVisitor) VisitEdition(e *parser.Edition) bool {
 func (v *orderVisitor) VisitPackage(p *parser.Package) bool {
 	next := v.machine.transit(v.state, packageVisitEvent)
 	if next == invalidOrderState {
-		v.AddFailuref(p.Meta.Pos, "The order of Package is invalid. Check if the file is ordered in the correct manner.")
+		v.AddFailureWithRangef(p.Meta.Pos, p.Meta.LastPos, "The order of Package is invalid. Check if the file is ordered in the correct manner.")
 	}
 	v.state = packageOrderState
 	v.formatter.pkg = p
@@ -123,7 +146,7 @@ func (v *orderVisitor) VisitPackage(p *parser.Package) bool {
 func (v *orderVisitor) VisitImport(i *parser.Import) bool {
 	next := v.machine.transit(v.state, importsVisitEvent)
 	if next == invalidOrderState {
-		v.AddFailuref(i.Meta.Pos, "The order of Import is invalid. Check if the file is ordered in the correct manner.")
+		v.AddFailureWithRangef(i.Meta.Pos, i.Meta.LastPos, "The order of Import is invalid. Check if the file is ordered in the correct manner.")
 	}
 	v.state = importsOrderState
 	v.formatter.addImports(i)
@@ -133,7 +156,7 @@ func (v *orderVisitor) VisitImport(i *parser.Import) bool {
 func (v *orderVisitor) VisitOption(o *parser.Option) bool {
 	next := v.machine.transit(v.state, fileOptionsVisitEvent)
 	if next == invalidOrderState {
-		v.AddFailuref(o.Meta.Pos, "The order of Option is invalid. Check if the file is ordered in the correct manner.")
+		v.AddFailureWithRangef(o.Meta.Pos, o.Meta.LastPos, "The order of Option is invalid. Check if the file is ordered in the correct manner.")
 	}
 	v.state = fileOptionsOrderState
 	v.formatter.addOptions(o)
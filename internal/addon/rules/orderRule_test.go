@@ -10,6 +10,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestOrderRule_Apply(t *testing.T) {
@@ -358,7 +359,7 @@ func TestOrderRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewOrderRule(rule.SeverityError, false)
+			rule := rules.NewOrderRule(rule.SeverityError, false, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -418,7 +419,7 @@ func TestOrderRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewOrderRule(rule.SeverityError, true)
+			r := rules.NewOrderRule(rule.SeverityError, true, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
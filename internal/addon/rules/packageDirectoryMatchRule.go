@@ -0,0 +1,165 @@
+package rules
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// PackageDirectoryMatchRule verifies that the package name matches the directory of the file,
+// and that all files in a directory declare the same package.
+type PackageDirectoryMatchRule struct {
+	RuleWithSeverity
+	root string
+	env  visitor.Env
+}
+
+// NewPackageDirectoryMatchRule creates a new PackageDirectoryMatchRule.
+func NewPackageDirectoryMatchRule(
+	severity rule.Severity,
+	root string,
+	env visitor.Env,
+) PackageDirectoryMatchRule {
+	return PackageDirectoryMatchRule{
+		RuleWithSeverity: RuleWithSeverity{severity: severity},
+		root:             root,
+		env:              env,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r PackageDirectoryMatchRule) ID() string {
+	return "PACKAGE_DIRECTORY_MATCH"
+}
+
+// Purpose returns the purpose of this rule.
+func (r PackageDirectoryMatchRule) Purpose() string {
+	return "Verifies that the package name matches the directory of the file and all files in a directory declare the same package."
+}
+
+// Documentation returns the rationale and the examples of this rule.
+func (r PackageDirectoryMatchRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "When the directories follow the packages, the readers find the file defining a type from its full name, and the code generators put the files of a package together.",
+		Bad: `// foo/bar/v1/song.proto
+package foo.v1;`,
+		Good: `// foo/bar/v1/song.proto
+package foo.bar.v1;`,
+	}
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r PackageDirectoryMatchRule) IsOfficial() bool {
+	return false
+}
+
+// Apply applies the rule to the proto, taking the default import paths as the roots.
+func (r PackageDirectoryMatchRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	return r.ApplyWithContext(symbol.NewImporter(symbol.DefaultImportPaths).Context(proto), proto)
+}
+
+// ApplyWithContext applies the rule to the proto with the files linted together.
+func (r PackageDirectoryMatchRule) ApplyWithContext(ctx *symbol.Context, proto *parser.Proto) ([]report.Failure, error) {
+	v := &packageDirectoryMatchVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
+		dir:            r.relativeDir(ctx, proto.Meta.Filename),
+		siblings:       siblingPackages(ctx, proto.Meta.Filename),
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+// relativeDir returns the slash-separated directory of the file relative to the root,
+// or "" if the root doesn't contain the file. The root is the import path containing the file by default.
+func (r PackageDirectoryMatchRule) relativeDir(ctx *symbol.Context, filename string) string {
+	if r.root == "" {
+		if ctx.ImportPath == "" {
+			return ""
+		}
+		return path.Dir(ctx.ImportPath)
+	}
+
+	root, err := filepath.Abs(r.root)
+	if err != nil {
+		return ""
+	}
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return ""
+	}
+	rel, err := filepath.Rel(root, abs)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return ""
+	}
+	return path.Dir(filepath.ToSlash(rel))
+}
+
+type siblingPackage struct {
+	path    string
+	pkgName string
+}
+
+// siblingPackages returns the packages of the other files linted together in the same directory.
+func siblingPackages(ctx *symbol.Context, filename string) []siblingPackage {
+	dir := filepath.Dir(filepath.Clean(filename))
+
+	var siblings []siblingPackage
+	for _, f := range ctx.Importer.LintedFiles() {
+		if filepath.Clean(f.Path) == filepath.Clean(filename) || filepath.Dir(filepath.Clean(f.Path)) != dir {
+			continue
+		}
+		if pkgName := symbol.PackageName(f.Proto); pkgName != "" {
+			siblings = append(siblings, siblingPackage{path: f.Path, pkgName: pkgName})
+		}
+	}
+	return siblings
+}
+
+type packageDirectoryMatchVisitor struct {
+	*visitor.BaseAddVisitor
+	dir      string
+	siblings []siblingPackage
+	pkg      *parser.Package
+}
+
+// VisitPackage records the package.
+func (v *packageDirectoryMatchVisitor) VisitPackage(p *parser.Package) bool {
+	v.pkg = p
+	return false
+}
+
+// Finally checks the package, which may be missing.
+func (v *packageDirectoryMatchVisitor) Finally(proto *parser.Proto) error {
+	// The files directly under the root have no directory to match.
+	hasDir := v.dir != "" && v.dir != "."
+	want := strings.ReplaceAll(v.dir, "/", ".")
+
+	if v.pkg == nil {
+		if hasDir {
+			v.AddFailurefWithProtoMeta(proto.Meta, "Package is missing, but should be %q to match the directory %q", want, v.dir)
+		}
+		if 0 < len(v.siblings) {
+			sibling := v.siblings[0]
+			v.AddFailurefWithProtoMeta(proto.Meta, "Package is missing, but %q is declared by %s in the same directory", sibling.pkgName, sibling.path)
+		}
+		return nil
+	}
+
+	p := v.pkg
+	if hasDir && p.Name != want {
+		v.AddFailureAtNamef(p.Meta.Pos, p.Meta.LastPos, p.Name, "Package name %q should be %q to match the directory %q", p.Name, want, v.dir)
+	}
+	for _, sibling := range v.siblings {
+		if sibling.pkgName != p.Name {
+			v.AddFailureAtNamef(p.Meta.Pos, p.Meta.LastPos, p.Name, "Package name %q differs from %q declared by %s in the same directory", p.Name, sibling.pkgName, sibling.path)
+			break
+		}
+	}
+	return nil
+}
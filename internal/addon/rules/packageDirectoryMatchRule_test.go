@@ -0,0 +1,137 @@
+package rules_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/addon/rules"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+func TestPackageDirectoryMatchRule_ApplyWithContext(t *testing.T) {
+	root := setting_test.TestDataPath("rules", "packageDirectoryMatch")
+	matchPath := filepath.Join(root, "foo", "bar", "v1", "match.proto")
+	mismatchPath := filepath.Join(root, "foo", "bar", "v1", "mismatch.proto")
+	noPkgPath := filepath.Join(root, "foo", "bar", "v1", "nopkg.proto")
+	rootPath := filepath.Join(root, "root.proto")
+
+	tests := []struct {
+		name         string
+		path         string
+		inputRoot    string
+		importPaths  []string
+		lintedPaths  []string
+		wantMessages []string
+	}{
+		{
+			name:      "no failures for the package matching the directory",
+			path:      matchPath,
+			inputRoot: root,
+		},
+		{
+			name:      "a failure for the package not matching the directory",
+			path:      mismatchPath,
+			inputRoot: root,
+			wantMessages: []string{
+				`Package name "foo.v1" should be "foo.bar.v1" to match the directory "foo/bar/v1"`,
+			},
+		},
+		{
+			name:        "the directory relative to the import path by default",
+			path:        mismatchPath,
+			importPaths: []string{root},
+			wantMessages: []string{
+				`Package name "foo.v1" should be "foo.bar.v1" to match the directory "foo/bar/v1"`,
+			},
+		},
+		{
+			name:        "no failures for the file outside the root",
+			path:        mismatchPath,
+			importPaths: []string{setting_test.TestDataPath("imports")},
+		},
+		{
+			name:      "no failures for the file directly under the root",
+			path:      rootPath,
+			inputRoot: root,
+		},
+		{
+			name:        "a failure for the package differing from the other file in the directory",
+			path:        matchPath,
+			inputRoot:   root,
+			lintedPaths: []string{matchPath, mismatchPath, rootPath},
+			wantMessages: []string{
+				`Package name "foo.bar.v1" differs from "foo.v1" declared by ` + mismatchPath + ` in the same directory`,
+			},
+		},
+		{
+			name:        "failures for both the directory and the other file",
+			path:        mismatchPath,
+			inputRoot:   root,
+			lintedPaths: []string{matchPath, mismatchPath},
+			wantMessages: []string{
+				`Package name "foo.v1" should be "foo.bar.v1" to match the directory "foo/bar/v1"`,
+				`Package name "foo.v1" differs from "foo.bar.v1" declared by ` + matchPath + ` in the same directory`,
+			},
+		},
+		{
+			name:      "a failure for the missing package in the directory",
+			path:      noPkgPath,
+			inputRoot: root,
+			wantMessages: []string{
+				`Package is missing, but should be "foo.bar.v1" to match the directory "foo/bar/v1"`,
+			},
+		},
+		{
+			name:        "a failure for the missing package next to the file declaring one",
+			path:        noPkgPath,
+			importPaths: []string{setting_test.TestDataPath("imports")},
+			lintedPaths: []string{matchPath, noPkgPath},
+			wantMessages: []string{
+				`Package is missing, but "foo.bar.v1" is declared by ` + matchPath + ` in the same directory`,
+			},
+		},
+		{
+			name:        "no failures for the missing package outside the root without the other files",
+			path:        noPkgPath,
+			importPaths: []string{setting_test.TestDataPath("imports")},
+			lintedPaths: []string{noPkgPath},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			proto, err := file.NewProtoFile(test.path, test.path).Parse(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			importer := symbol.NewImporter(test.importPaths)
+			var linted []file.ProtoFile
+			for _, p := range test.lintedPaths {
+				linted = append(linted, file.NewProtoFile(p, p))
+			}
+			importer.SetLintedFiles(linted)
+
+			rule := rules.NewPackageDirectoryMatchRule(rule.SeverityError, test.inputRoot, visitor.Env{})
+			got, err := rule.ApplyWithContext(importer.Context(proto), proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+
+			var gotMessages []string
+			for _, f := range got {
+				gotMessages = append(gotMessages, f.Message())
+			}
+			if !reflect.DeepEqual(gotMessages, test.wantMessages) {
+				t.Errorf("got %v, but want %v", gotMessages, test.wantMessages)
+			}
+		})
+	}
+}
@@ -18,16 +18,19 @@ import (
 type PackageNameLowerCaseRule struct {
 	RuleWithSeverity
 	fixMode bool
+	env     visitor.Env
 }
 
 // NewPackageNameLowerCaseRule creates a new PackageNameLowerCaseRule.
 func NewPackageNameLowerCaseRule(
 	severity rule.Severity,
 	fixMode bool,
+	env visitor.Env,
 ) PackageNameLowerCaseRule {
 	return PackageNameLowerCaseRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
+		env:              env,
 	}
 }
 
@@ -41,14 +44,28 @@ func (r PackageNameLowerCaseRule) Purpose() string {
 	return "Verifies that the package name doesn't contain any uppercase letters."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r PackageNameLowerCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the packages in lower case, which avoids the conflicts between the package names and the type names in the generated code.",
+		Bad:       `package myPackage;`,
+		Good:      `package my.package;`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r PackageNameLowerCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r PackageNameLowerCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r PackageNameLowerCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -68,7 +85,7 @@ func (v *packageNameLowerCaseVisitor) VisitPackage(p *parser.Package) bool {
 	name := p.Name
 	if !isPackageLowerCase(name) {
 		expected := strings.ToLower(name)
-		v.AddFailuref(p.Meta.Pos, "Package name %q must not contain any uppercase letter. Consider to change like %q.", name, expected)
+		v.AddFailureAtNamef(p.Meta.Pos, p.Meta.LastPos, p.Name, "Package name %q must not contain any uppercase letter. Consider to change like %q.", name, expected)
 
 		err := v.Fixer.SearchAndReplace(p.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
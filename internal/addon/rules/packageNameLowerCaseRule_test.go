@@ -10,6 +10,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestPackageNameLowerCaseRule_Apply(t *testing.T) {
@@ -92,7 +93,7 @@ func TestPackageNameLowerCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewPackageNameLowerCaseRule(rule.SeverityError, false)
+			rule := rules.NewPackageNameLowerCaseRule(rule.SeverityError, false, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -127,7 +128,7 @@ func TestPackageNameLowerCaseRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewPackageNameLowerCaseRule(rule.SeverityError, true)
+			r := rules.NewPackageNameLowerCaseRule(rule.SeverityError, true, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -14,16 +14,19 @@ import (
 type Proto3FieldsAvoidRequiredRule struct {
 	RuleWithSeverity
 	fixMode bool
+	env     visitor.Env
 }
 
 // NewProto3FieldsAvoidRequiredRule creates a new Proto3FieldsAvoidRequiredRule.
 func NewProto3FieldsAvoidRequiredRule(
 	severity rule.Severity,
 	fixMode bool,
+	env visitor.Env,
 ) Proto3FieldsAvoidRequiredRule {
 	return Proto3FieldsAvoidRequiredRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
+		env:              env,
 	}
 }
 
@@ -37,14 +40,34 @@ func (r Proto3FieldsAvoidRequiredRule) Purpose() string {
 	return "Verifies that all fields should avoid required for proto3."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r Proto3FieldsAvoidRequiredRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "proto3 removed the required label, because a required field can never be removed without breaking the older readers.",
+		Bad: `syntax = "proto3";
+message Foo {
+  required string name = 1;
+}`,
+		Good: `syntax = "proto3";
+message Foo {
+  string name = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r Proto3FieldsAvoidRequiredRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r Proto3FieldsAvoidRequiredRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r Proto3FieldsAvoidRequiredRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +92,7 @@ func (v *proto3FieldsAvoidRequiredVisitor) VisitSyntax(s *parser.Syntax) bool {
 // VisitField checks the field.
 func (v *proto3FieldsAvoidRequiredVisitor) VisitField(field *parser.Field) bool {
 	if v.isProto3 && field.IsRequired {
-		v.AddFailuref(field.Meta.Pos, `Field %q should avoid required for proto3`, field.FieldName)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, field.FieldName, `Field %q should avoid required for proto3`, field.FieldName)
 
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
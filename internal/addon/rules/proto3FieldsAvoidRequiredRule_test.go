@@ -9,6 +9,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestProto3FieldsAvoidRequiredRule_Apply(t *testing.T) {
@@ -151,7 +152,7 @@ func TestProto3FieldsAvoidRequiredRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewProto3FieldsAvoidRequiredRule(rule.SeverityError, false)
+			rule := rules.NewProto3FieldsAvoidRequiredRule(rule.SeverityError, false, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -186,7 +187,7 @@ func TestProto3FieldsAvoidRequiredRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewProto3FieldsAvoidRequiredRule(rule.SeverityError, true)
+			r := rules.NewProto3FieldsAvoidRequiredRule(rule.SeverityError, true, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -13,16 +13,19 @@ import (
 type Proto3GroupsAvoidRule struct {
 	RuleWithSeverity
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewProto3GroupsAvoidRule creates a new Proto3GroupsAvoidRule.
 func NewProto3GroupsAvoidRule(
 	severity rule.Severity,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) Proto3GroupsAvoidRule {
 	return Proto3GroupsAvoidRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -36,6 +39,24 @@ func (r Proto3GroupsAvoidRule) Purpose() string {
 	return "Verifies that all groups should be avoided for proto3."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r Proto3GroupsAvoidRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "proto3 doesn't support groups, which are deprecated in favor of the nested messages.",
+		Bad: `message Foo {
+  repeated group Result = 1 {
+    string url = 2;
+  }
+}`,
+		Good: `message Foo {
+  message Result {
+    string url = 2;
+  }
+  repeated Result result = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r Proto3GroupsAvoidRule) IsOfficial() bool {
 	return true
@@ -44,7 +65,7 @@ func (r Proto3GroupsAvoidRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r Proto3GroupsAvoidRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &proto3GroupsAvoidVisitor{
-		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor: visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 	}
 	return visitor.RunVisitorAutoDisable(v, proto, r.ID(), r.autoDisableType)
 }
@@ -63,7 +84,7 @@ func (v *proto3GroupsAvoidVisitor) VisitSyntax(s *parser.Syntax) bool {
 // VisitGroupField checks the group field.
 func (v *proto3GroupsAvoidVisitor) VisitGroupField(field *parser.GroupField) bool {
 	if v.isProto3 {
-		v.AddFailuref(field.Meta.Pos, `Group %q should be avoided for proto3`, field.GroupName)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, field.GroupName, `Group %q should be avoided for proto3`, field.GroupName)
 	}
 	return false
 }
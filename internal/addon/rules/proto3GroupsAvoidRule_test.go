@@ -10,6 +10,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestProto3GroupsAvoidRule_Apply(t *testing.T) {
@@ -112,7 +113,7 @@ func TestProto3GroupsAvoidRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewProto3GroupsAvoidRule(rule.SeverityError, autodisable.Noop)
+			rule := rules.NewProto3GroupsAvoidRule(rule.SeverityError, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -155,7 +156,7 @@ func TestProto3GroupsAvoidRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewProto3GroupsAvoidRule(rule.SeverityError, test.inputPlacementType)
+			r := rules.NewProto3GroupsAvoidRule(rule.SeverityError, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
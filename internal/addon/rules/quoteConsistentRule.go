@@ -16,6 +16,7 @@ type QuoteConsistentRule struct {
 	quote config.QuoteType
 
 	fixMode bool
+	env     visitor.Env
 }
 
 // NewQuoteConsistentRule creates a new QuoteConsistentRule.
@@ -23,11 +24,13 @@ func NewQuoteConsistentRule(
 	severity rule.Severity,
 	quote config.QuoteType,
 	fixMode bool,
+	env visitor.Env,
 ) QuoteConsistentRule {
 	return QuoteConsistentRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		quote:            quote,
 		fixMode:          fixMode,
+		env:              env,
 	}
 }
 
@@ -41,14 +44,28 @@ func (r QuoteConsistentRule) Purpose() string {
 	return "Verifies that the use of quote for strings is consistent."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r QuoteConsistentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A single quote style across the files keeps them consistent and the diffs free from the style changes.",
+		Bad:       `import 'google/protobuf/empty.proto';`,
+		Good:      `import "google/protobuf/empty.proto";`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r QuoteConsistentRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r QuoteConsistentRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r QuoteConsistentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +86,7 @@ func (v quoteConsistentVisitor) VisitSyntax(s *parser.Syntax) bool {
 	str := s.ProtobufVersionQuote
 	converted := convertConsistentQuote(str, v.quote)
 	if str != converted {
-		v.AddFailuref(s.Meta.Pos, "Quoted string should be %s but was %s.", converted, str)
+		v.AddFailureWithRangef(s.Meta.Pos, s.Meta.LastPos, "Quoted string should be %s but was %s.", converted, str)
 		v.Fixer.ReplaceText(s.Meta.Pos.Line, str, converted)
 	}
 	return false
@@ -79,7 +96,7 @@ func (v quoteConsistentVisitor) VisitEdition(s *parser.Edition) bool {
 	str := s.EditionQuote
 	converted := convertConsistentQuote(str, v.quote)
 	if str != converted {
-		v.AddFailuref(s.Meta.Pos, "Quoted string should be %s but was %s.", converted, str)
+		v.AddFailureWithRangef(s.Meta.Pos, s.Meta.LastPos, "Quoted string should be %s but was %s.", converted, str)
 		v.Fixer.ReplaceText(s.Meta.Pos.Line, str, converted)
 	}
 	return false
@@ -89,7 +106,7 @@ func (v quoteConsistentVisitor) VisitImport(i *parser.Import) (next bool) {
 	str := i.Location
 	converted := convertConsistentQuote(str, v.quote)
 	if str != converted {
-		v.AddFailuref(i.Meta.Pos, "Quoted string should be %s but was %s.", converted, str)
+		v.AddFailureWithRangef(i.Meta.Pos, i.Meta.LastPos, "Quoted string should be %s but was %s.", converted, str)
 		v.Fixer.ReplaceText(i.Meta.Pos.Line, str, converted)
 	}
 	return false
@@ -99,7 +116,7 @@ func (v quoteConsistentVisitor) VisitOption(o *parser.Option) (next bool) {
 	str := o.Constant
 	converted := convertConsistentQuote(str, v.quote)
 	if str != converted {
-		v.AddFailuref(o.Meta.Pos, "Quoted string should be %s but was %s.", converted, str)
+		v.AddFailureWithRangef(o.Meta.Pos, o.Meta.LastPos, "Quoted string should be %s but was %s.", converted, str)
 		v.Fixer.ReplaceText(o.Meta.Pos.Line, str, converted)
 	}
 	return false
@@ -110,7 +127,7 @@ func (v quoteConsistentVisitor) VisitEnumField(f *parser.EnumField) (next bool)
 		str := option.Constant
 		converted := convertConsistentQuote(str, v.quote)
 		if str != converted {
-			v.AddFailuref(f.Meta.Pos, "Quoted string should be %s but was %s.", converted, str)
+			v.AddFailureWithRangef(f.Meta.Pos, f.Meta.LastPos, "Quoted string should be %s but was %s.", converted, str)
 			v.Fixer.ReplaceText(f.Meta.Pos.Line, str, converted)
 		}
 	}
@@ -122,7 +139,7 @@ func (v quoteConsistentVisitor) VisitField(f *parser.Field) (next bool) {
 		str := option.Constant
 		converted := convertConsistentQuote(str, v.quote)
 		if str != converted {
-			v.AddFailuref(f.Meta.Pos, "Quoted string should be %s but was %s.", converted, str)
+			v.AddFailureWithRangef(f.Meta.Pos, f.Meta.LastPos, "Quoted string should be %s but was %s.", converted, str)
 			v.Fixer.ReplaceText(f.Meta.Pos.Line, str, converted)
 		}
 	}
@@ -12,6 +12,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestQuoteConsistentRule_Apply(t *testing.T) {
@@ -246,7 +247,7 @@ func TestQuoteConsistentRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewQuoteConsistentRule(rule.SeverityError, test.inputQuote, false)
+			rule := rules.NewQuoteConsistentRule(rule.SeverityError, test.inputQuote, false, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -294,6 +295,7 @@ func TestQuoteConsistentRule_Apply_fix(t *testing.T) {
 				rule.SeverityError,
 				test.inputQuote,
 				true,
+				visitor.Env{},
 			)
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
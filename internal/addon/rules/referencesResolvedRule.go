@@ -0,0 +1,148 @@
+package rules
+
+import (
+	"errors"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// ReferencesResolvedRule verifies that all imports are found under the import paths
+// and all referenced types are defined.
+type ReferencesResolvedRule struct {
+	RuleWithSeverity
+	env visitor.Env
+}
+
+// NewReferencesResolvedRule creates a new ReferencesResolvedRule.
+func NewReferencesResolvedRule(
+	severity rule.Severity,
+	env visitor.Env,
+) ReferencesResolvedRule {
+	return ReferencesResolvedRule{
+		RuleWithSeverity: RuleWithSeverity{severity: severity},
+		env:              env,
+	}
+}
+
+// ID returns the ID of this rule.
+func (r ReferencesResolvedRule) ID() string {
+	return "REFERENCES_RESOLVED"
+}
+
+// Purpose returns the purpose of this rule.
+func (r ReferencesResolvedRule) Purpose() string {
+	return "Verifies that all imports are found under the import paths and all referenced types are defined."
+}
+
+// Documentation returns the rationale and the examples of this rule.
+func (r ReferencesResolvedRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "protoc rejects a file whose imports or types can't be found, so catching them while linting saves a round trip through the code generation. The undefined types are reported only when all the imports are resolved, because they may be defined in the missing files.",
+		Bad: `import "google/protobuf/timestamp.proto";
+
+message Song {
+  google.protobuf.Duration length = 1;
+}`,
+		Good: `import "google/protobuf/duration.proto";
+
+message Song {
+  google.protobuf.Duration length = 1;
+}`,
+	}
+}
+
+// IsOfficial decides whether or not this rule belongs to the official guide.
+func (r ReferencesResolvedRule) IsOfficial() bool {
+	return false
+}
+
+// Apply applies the rule to the proto, searching the default import paths for the imports.
+func (r ReferencesResolvedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	return r.ApplyWithContext(symbol.NewImporter(symbol.DefaultImportPaths).Context(proto), proto)
+}
+
+// ApplyWithContext applies the rule to the proto with the resolved imports.
+func (r ReferencesResolvedRule) ApplyWithContext(ctx *symbol.Context, proto *parser.Proto) ([]report.Failure, error) {
+	v := &referencesResolvedVisitor{
+		BaseAddVisitor: visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
+		importErrors:   make(map[*parser.Import]error),
+		undefined:      make(map[meta.Position][]symbol.Reference),
+	}
+	for _, imp := range ctx.Imports {
+		if imp.Err != nil {
+			v.importErrors[imp.Import] = imp.Err
+		}
+	}
+	if !ctx.HasUnresolvedImports() {
+		for _, ref := range symbol.References(proto) {
+			if _, ok := ctx.Table.Resolve(ref.Scope, ref.Name); !ok {
+				v.undefined[ref.Pos] = append(v.undefined[ref.Pos], ref)
+			}
+		}
+	}
+	return visitor.RunVisitor(v, proto, r.ID())
+}
+
+type referencesResolvedVisitor struct {
+	*visitor.BaseAddVisitor
+	importErrors map[*parser.Import]error
+	// undefined are the references to the undefined types, keyed by the position of the referencing element.
+	undefined map[meta.Position][]symbol.Reference
+}
+
+// VisitImport checks the import.
+func (v *referencesResolvedVisitor) VisitImport(i *parser.Import) bool {
+	err, ok := v.importErrors[i]
+	if !ok {
+		return false
+	}
+	if errors.Is(err, symbol.ErrImportNotFound) {
+		v.AddFailureWithRangef(i.Meta.Pos, i.Meta.LastPos, "Imported file %s is not found in the import paths", i.Location)
+	} else {
+		v.AddFailureWithRangef(i.Meta.Pos, i.Meta.LastPos, "Imported file %s can't be parsed: %v", i.Location, err)
+	}
+	return false
+}
+
+// VisitField checks the field.
+func (v *referencesResolvedVisitor) VisitField(f *parser.Field) bool {
+	v.checkReferences(f.Meta)
+	return false
+}
+
+// VisitMapField checks the map field.
+func (v *referencesResolvedVisitor) VisitMapField(m *parser.MapField) bool {
+	v.checkReferences(m.Meta)
+	return false
+}
+
+// VisitOneofField checks the oneof field.
+func (v *referencesResolvedVisitor) VisitOneofField(o *parser.OneofField) bool {
+	v.checkReferences(o.Meta)
+	return false
+}
+
+// VisitExtend checks the extended type.
+func (v *referencesResolvedVisitor) VisitExtend(e *parser.Extend) bool {
+	v.checkReferences(e.Meta)
+	return true
+}
+
+// VisitRPC checks the request and response types.
+func (v *referencesResolvedVisitor) VisitRPC(r *parser.RPC) bool {
+	v.checkReferences(r.RPCRequest.Meta)
+	v.checkReferences(r.RPCResponse.Meta)
+	return false
+}
+
+func (v *referencesResolvedVisitor) checkReferences(m meta.Meta) {
+	for _, ref := range v.undefined[m.Pos] {
+		v.AddFailureAtTypef(m.Pos, m.LastPos, ref.Name, "Type %q is not defined in the file or the files it imports", ref.Name)
+	}
+}
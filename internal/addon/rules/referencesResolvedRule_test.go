@@ -0,0 +1,95 @@
+package rules_test
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/addon/rules"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+func TestReferencesResolvedRule_ApplyWithContext(t *testing.T) {
+	root := setting_test.TestDataPath("imports")
+	fooPath := filepath.Join(root, "foo", "v1", "foo.proto")
+	bazPath := filepath.Join(root, "baz", "v1", "baz.proto")
+
+	tests := []struct {
+		name         string
+		path         string
+		importPaths  []string
+		wantFailures []report.Failure
+	}{
+		{
+			name:        "a failure for an import not found, without checking the types",
+			path:        fooPath,
+			importPaths: []string{root},
+			wantFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{Filename: fooPath, Offset: 64, Line: 6, Column: 1},
+					meta.Position{Filename: fooPath, Offset: 97, Line: 6, Column: 34},
+					"REFERENCES_RESOLVED",
+					string(rule.SeverityError),
+					`Imported file "missing/v1/missing.proto" is not found in the import paths`,
+				),
+			},
+		},
+		{
+			name:        "failures for the undefined types",
+			path:        bazPath,
+			importPaths: []string{root},
+			wantFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{Filename: bazPath, Offset: 212, Line: 12, Column: 3},
+					meta.Position{Filename: bazPath, Offset: 220, Line: 12, Column: 11},
+					"REFERENCES_RESOLVED",
+					string(rule.SeverityError),
+					`Type "Undefined" is not defined in the file or the files it imports`,
+				),
+				report.FailureWithRangef(
+					meta.Position{Filename: bazPath, Offset: 310, Line: 15, Column: 3},
+					meta.Position{Filename: bazPath, Offset: 335, Line: 15, Column: 28},
+					"REFERENCES_RESOLVED",
+					string(rule.SeverityError),
+					`Type "bar.v1.Missing" is not defined in the file or the files it imports`,
+				),
+				report.FailureWithRangef(
+					meta.Position{Filename: bazPath, Offset: 402, Line: 19, Column: 27},
+					meta.Position{Filename: bazPath, Offset: 413, Line: 19, Column: 38},
+					"REFERENCES_RESOLVED",
+					string(rule.SeverityError),
+					`Type "BazResponse" is not defined in the file or the files it imports`,
+				),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			proto, err := file.NewProtoFile(test.path, test.path).Parse(false)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rule := rules.NewReferencesResolvedRule(rule.SeverityError, visitor.Env{})
+			ctx := symbol.NewImporter(test.importPaths).Context(proto)
+
+			got, err := rule.ApplyWithContext(ctx, proto)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantFailures) {
+				t.Errorf("got %v, but want %v", got, test.wantFailures)
+			}
+		})
+	}
+}
@@ -24,6 +24,7 @@ type RepeatedFieldNamesPluralizedRule struct {
 	irregularRules   map[string]string
 	fixMode          bool
 	autoDisableType  autodisable.PlacementType
+	env              visitor.Env
 }
 
 // NewRepeatedFieldNamesPluralizedRule creates a new RepeatedFieldNamesPluralizedRule.
@@ -35,6 +36,7 @@ func NewRepeatedFieldNamesPluralizedRule(
 	irregularRules map[string]string,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) RepeatedFieldNamesPluralizedRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -47,6 +49,7 @@ func NewRepeatedFieldNamesPluralizedRule(
 		irregularRules:   irregularRules,
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -60,11 +63,29 @@ func (r RepeatedFieldNamesPluralizedRule) Purpose() string {
 	return "Verifies that repeated field names are pluralized names."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r RepeatedFieldNamesPluralizedRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the repeated fields in plural, which tells that the field holds a list.",
+		Bad: `message Foo {
+  repeated string song_name = 1;
+}`,
+		Good: `message Foo {
+  repeated string song_names = 1;
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r RepeatedFieldNamesPluralizedRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r RepeatedFieldNamesPluralizedRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r RepeatedFieldNamesPluralizedRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	c := strs.NewPluralizeClient()
@@ -81,7 +102,7 @@ func (r RepeatedFieldNamesPluralizedRule) Apply(proto *parser.Proto) ([]report.F
 		c.AddIrregularRule(k, v)
 	}
 
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +124,7 @@ func (v *repeatedFieldNamesPluralizedVisitor) VisitField(field *parser.Field) bo
 	got := field.FieldName
 	want := v.pluralizeClient.ToPlural(got)
 	if field.IsRepeated && strings.ToLower(got) != strings.ToLower(want) {
-		v.AddFailuref(field.Meta.Pos, "Repeated field name %q must be pluralized name %q", got, want)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, got, "Repeated field name %q must be pluralized name %q", got, want)
 
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
@@ -132,7 +153,7 @@ func (v *repeatedFieldNamesPluralizedVisitor) VisitGroupField(field *parser.Grou
 	got := field.GroupName
 	want := v.pluralizeClient.ToPlural(got)
 	if field.IsRepeated && strings.ToLower(got) != strings.ToLower(want) {
-		v.AddFailuref(field.Meta.Pos, "Repeated group name %q must be pluralized name %q", got, want)
+		v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, got, "Repeated group name %q must be pluralized name %q", got, want)
 
 		err := v.Fixer.SearchAndReplace(field.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
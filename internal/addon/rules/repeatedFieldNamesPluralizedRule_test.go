@@ -10,6 +10,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestRepeatedFieldNamesPluralizedRule_Apply(t *testing.T) {
@@ -234,6 +235,7 @@ func TestRepeatedFieldNamesPluralizedRule_Apply(t *testing.T) {
 				test.irregularRules,
 				false,
 				autodisable.Noop,
+				visitor.Env{},
 			)
 
 			got, err := rule.Apply(test.inputProto)
@@ -281,6 +283,7 @@ func TestRepeatedFieldNamesPluralizedRule_Apply_fix(t *testing.T) {
 				test.irregularRules,
 				true,
 				autodisable.Noop,
+				visitor.Env{},
 			)
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
@@ -328,6 +331,7 @@ func TestRepeatedFieldNamesPluralizedRule_Apply_disable(t *testing.T) {
 				test.irregularRules,
 				true,
 				test.inputPlacementType,
+				visitor.Env{},
 			)
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
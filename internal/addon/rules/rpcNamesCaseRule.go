@@ -14,16 +14,19 @@ import (
 type RPCNamesCaseRule struct {
 	RuleWithSeverity
 	convention config.ConventionType
+	env        visitor.Env
 }
 
 // NewRPCNamesCaseRule creates a new RPCNamesCaseRule.
 func NewRPCNamesCaseRule(
 	severity rule.Severity,
 	convention config.ConventionType,
+	env visitor.Env,
 ) RPCNamesCaseRule {
 	return RPCNamesCaseRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		convention:       convention,
+		env:              env,
 	}
 }
 
@@ -37,6 +40,19 @@ func (r RPCNamesCaseRule) Purpose() string {
 	return "Verifies that all rpc names conform to the specified convention."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r RPCNamesCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "Some code bases name the rpcs in another convention than CamelCase, and this rule keeps them consistent with the configured one.",
+		Bad: `service FooService {
+  rpc get_something(FooRequest) returns (FooResponse);
+}`,
+		Good: `service FooService {
+  rpc getSomething(FooRequest) returns (FooResponse);
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r RPCNamesCaseRule) IsOfficial() bool {
 	return false
@@ -45,7 +61,7 @@ func (r RPCNamesCaseRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r RPCNamesCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &rpcNamesCaseVisitor{
-		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor: visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		convention:     r.convention,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
@@ -59,11 +75,11 @@ type rpcNamesCaseVisitor struct {
 // VisitRPC checks the rpc.
 func (v *rpcNamesCaseVisitor) VisitRPC(rpc *parser.RPC) bool {
 	if v.convention == config.ConventionLowerCamel && !strs.IsLowerCamelCase(rpc.RPCName) {
-		v.AddFailuref(rpc.Meta.Pos, "RPC name %q must be LowerCamelCase", rpc.RPCName)
+		v.AddFailureAtNamef(rpc.Meta.Pos, rpc.Meta.LastPos, rpc.RPCName, "RPC name %q must be LowerCamelCase", rpc.RPCName)
 	} else if v.convention == config.ConventionUpperSnake && !strs.IsUpperSnakeCase(rpc.RPCName) {
-		v.AddFailuref(rpc.Meta.Pos, "RPC name %q must be UpperSnakeCase", rpc.RPCName)
+		v.AddFailureAtNamef(rpc.Meta.Pos, rpc.Meta.LastPos, rpc.RPCName, "RPC name %q must be UpperSnakeCase", rpc.RPCName)
 	} else if v.convention == config.ConventionLowerSnake && !strs.IsLowerSnakeCase(rpc.RPCName) {
-		v.AddFailuref(rpc.Meta.Pos, "RPC name %q must be LowerSnakeCase", rpc.RPCName)
+		v.AddFailureAtNamef(rpc.Meta.Pos, rpc.Meta.LastPos, rpc.RPCName, "RPC name %q must be LowerSnakeCase", rpc.RPCName)
 	}
 	return false
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestRPCNamesCaseRule_Apply(t *testing.T) {
@@ -319,7 +320,7 @@ func TestRPCNamesCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewRPCNamesCaseRule(rule.SeverityError, test.inputConvention)
+			rule := rules.NewRPCNamesCaseRule(rule.SeverityError, test.inputConvention, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
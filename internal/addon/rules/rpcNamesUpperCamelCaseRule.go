@@ -18,6 +18,7 @@ type RPCNamesUpperCamelCaseRule struct {
 	RuleWithSeverity
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewRPCNamesUpperCamelCaseRule creates a new RPCNamesUpperCamelCaseRule.
@@ -25,6 +26,7 @@ func NewRPCNamesUpperCamelCaseRule(
 	severity rule.Severity,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) RPCNamesUpperCamelCaseRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -33,6 +35,7 @@ func NewRPCNamesUpperCamelCaseRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -46,14 +49,32 @@ func (r RPCNamesUpperCamelCaseRule) Purpose() string {
 	return "Verifies that all rpc names are CamelCase (with an initial capital)."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r RPCNamesUpperCamelCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the rpcs in CamelCase, which fits the method names in the generated code.",
+		Bad: `service FooService {
+  rpc get_something(FooRequest) returns (FooResponse);
+}`,
+		Good: `service FooService {
+  rpc GetSomething(FooRequest) returns (FooResponse);
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r RPCNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r RPCNamesUpperCamelCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r RPCNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +94,7 @@ func (v *rpcNamesUpperCamelCaseVisitor) VisitRPC(rpc *parser.RPC) bool {
 	name := rpc.RPCName
 	if !strs.IsUpperCamelCase(name) {
 		expected := strs.ToUpperCamelCase(name)
-		v.AddFailuref(rpc.Meta.Pos, "RPC name %q must be UpperCamelCase like %q", name, expected)
+		v.AddFailureAtNamef(rpc.Meta.Pos, rpc.Meta.LastPos, name, "RPC name %q must be UpperCamelCase like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(rpc.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
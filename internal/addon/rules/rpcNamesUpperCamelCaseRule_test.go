@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestRPCNamesUpperCamelCaseRule_Apply(t *testing.T) {
@@ -116,7 +117,7 @@ func TestRPCNamesUpperCamelCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewRPCNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop)
+			rule := rules.NewRPCNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -151,7 +152,7 @@ func TestRPCNamesUpperCamelCaseRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewRPCNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop)
+			r := rules.NewRPCNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -198,7 +199,7 @@ func TestRPCNamesUpperCamelCaseRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewRPCNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType)
+			r := rules.NewRPCNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
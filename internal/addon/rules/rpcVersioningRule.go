@@ -34,6 +34,19 @@ func (r RPCVersioningRule) Purpose() string {
     return "Verifies that all RPC URLs have a prefix /v{num}."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r RPCVersioningRule) Documentation() rule.Documentation {
+    return rule.Documentation{
+        Rationale: "A major version in the HTTP path lets the API evolve with breaking changes without breaking the existing clients.",
+        Bad: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = { get: "/books/{id}" };
+}`,
+        Good: `rpc GetBook(GetBookRequest) returns (Book) {
+  option (google.api.http) = { get: "/v1/books/{id}" };
+}`,
+    }
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r RPCVersioningRule) IsOfficial() bool {
     return true
@@ -58,7 +71,7 @@ func (v *rpcVersioningVisitor) VisitRPC(rpc *parser.RPC) bool {
         if option.OptionName == "(google.api.http)" { // Use the correct field name
             optionURL := extractURLFromOption(option.Constant)
             if optionURL != "" && !versioningRegex.MatchString(optionURL) {
-                v.AddFailuref(option.Meta.Pos, `Option URL %q in RPC %q should have a prefix of the form "/v{num}"`, optionURL, rpc.RPCName)
+                v.AddFailureWithRangef(option.Meta.Pos, option.Meta.LastPos, `Option URL %q in RPC %q should have a prefix of the form "/v{num}"`, optionURL, rpc.RPCName)
             }
         }
     }
@@ -4,7 +4,7 @@ import (
     "reflect"
     "testing"
 
-    "github.com//go-protoparser/v4/parser"
+    "github.com/yoheimuta/go-protoparser/v4/parser"
     "github.com/yoheimuta/go-protoparser/v4/parser/meta"
     "github.com/maramkhaledn/protolint/internal/addon/rules"
     "github.com/maramkhaledn/protolint/linter/rule"
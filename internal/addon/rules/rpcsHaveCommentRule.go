@@ -14,16 +14,19 @@ type RPCsHaveCommentRule struct {
 	// Golang style comments should begin with the name of the thing being described.
 	// See https://github.com/golang/go/wiki/CodeReviewComments#comment-sentences
 	shouldFollowGolangStyle bool
+	env                     visitor.Env
 }
 
 // NewRPCsHaveCommentRule creates a new RPCsHaveCommentRule.
 func NewRPCsHaveCommentRule(
 	severity rule.Severity,
 	shouldFollowGolangStyle bool,
+	env visitor.Env,
 ) RPCsHaveCommentRule {
 	return RPCsHaveCommentRule{
 		RuleWithSeverity:        RuleWithSeverity{severity: severity},
 		shouldFollowGolangStyle: shouldFollowGolangStyle,
+		env:                     env,
 	}
 }
 
@@ -37,6 +40,20 @@ func (r RPCsHaveCommentRule) Purpose() string {
 	return "Verifies that all rpcs have a comment."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r RPCsHaveCommentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A comment on each rpc documents the behavior of the method for the readers of the generated code.",
+		Bad: `service FooService {
+  rpc GetFoo(GetFooRequest) returns (Foo);
+}`,
+		Good: `service FooService {
+  // GetFoo returns the foo of the ID.
+  rpc GetFoo(GetFooRequest) returns (Foo);
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r RPCsHaveCommentRule) IsOfficial() bool {
 	return false
@@ -45,7 +62,7 @@ func (r RPCsHaveCommentRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r RPCsHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &rpcsHaveCommentVisitor{
-		BaseAddVisitor:          visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor:          visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		shouldFollowGolangStyle: r.shouldFollowGolangStyle,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
@@ -60,9 +77,9 @@ type rpcsHaveCommentVisitor struct {
 func (v *rpcsHaveCommentVisitor) VisitRPC(rpc *parser.RPC) bool {
 	n := rpc.RPCName
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(rpc.Comments, n) {
-		v.AddFailuref(rpc.Meta.Pos, `RPC %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(rpc.Meta.Pos, rpc.Meta.LastPos, n, `RPC %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(rpc.Comments, rpc.InlineComment, rpc.InlineCommentBehindLeftCurly) {
-		v.AddFailuref(rpc.Meta.Pos, `RPC %q should have a comment`, n)
+		v.AddFailureAtNamef(rpc.Meta.Pos, rpc.Meta.LastPos, n, `RPC %q should have a comment`, n)
 	}
 	return false
 }
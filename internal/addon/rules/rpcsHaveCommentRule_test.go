@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestRPCsHaveCommentRule_Apply(t *testing.T) {
@@ -184,7 +185,7 @@ func TestRPCsHaveCommentRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewRPCsHaveCommentRule(rule.SeverityError, test.inputShouldFollowGolangStyle)
+			rule := rules.NewRPCsHaveCommentRule(rule.SeverityError, test.inputShouldFollowGolangStyle, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -14,16 +14,19 @@ import (
 type ServiceNamesEndWithRule struct {
 	RuleWithSeverity
 	text string
+	env  visitor.Env
 }
 
 // NewServiceNamesEndWithRule creates a new ServiceNamesEndWithRule.
 func NewServiceNamesEndWithRule(
 	severity rule.Severity,
 	text string,
+	env visitor.Env,
 ) ServiceNamesEndWithRule {
 	return ServiceNamesEndWithRule{
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		text:             text,
+		env:              env,
 	}
 }
 
@@ -37,6 +40,17 @@ func (r ServiceNamesEndWithRule) Purpose() string {
 	return "Verifies that all service names end with the specified value."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r ServiceNamesEndWithRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A common suffix tells the services from the messages in the generated code.",
+		Bad: `service Song {
+}`,
+		Good: `service SongService {
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r ServiceNamesEndWithRule) IsOfficial() bool {
 	return false
@@ -45,7 +59,7 @@ func (r ServiceNamesEndWithRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r ServiceNamesEndWithRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &serviceNamesEndWithVisitor{
-		BaseAddVisitor: visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor: visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		text:           r.text,
 	}
 
@@ -60,7 +74,7 @@ type serviceNamesEndWithVisitor struct {
 // VisitService checks the service.
 func (v *serviceNamesEndWithVisitor) VisitService(service *parser.Service) bool {
 	if !strings.HasSuffix(service.ServiceName, v.text) {
-		v.AddFailuref(service.Meta.Pos, "Service name %q must end with %s", service.ServiceName, v.text)
+		v.AddFailureAtNamef(service.Meta.Pos, service.Meta.LastPos, service.ServiceName, "Service name %q must end with %s", service.ServiceName, v.text)
 	}
 	return false
 }
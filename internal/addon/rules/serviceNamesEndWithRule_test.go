@@ -10,6 +10,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/addon/rules"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestValidServiceNamesEndWithRule_Apply(t *testing.T) {
@@ -32,7 +33,7 @@ func TestValidServiceNamesEndWithRule_Apply(t *testing.T) {
 	}
 
 	t.Run(validTestCase.name, func(t *testing.T) {
-		rule := rules.NewServiceNamesEndWithRule(rule.SeverityError, "Service")
+		rule := rules.NewServiceNamesEndWithRule(rule.SeverityError, "Service", visitor.Env{})
 
 		_, err := rule.Apply(validTestCase.inputProto)
 		if err != nil {
@@ -66,7 +67,7 @@ func TestInvalidServiceNamesEndWithRule_Apply(t *testing.T) {
 	}
 
 	t.Run(invalidTestCase.name, func(t *testing.T) {
-		rule := rules.NewServiceNamesEndWithRule(rule.SeverityError, "Service")
+		rule := rules.NewServiceNamesEndWithRule(rule.SeverityError, "Service", visitor.Env{})
 
 		got, err := rule.Apply(invalidTestCase.inputProto)
 		if err != nil {
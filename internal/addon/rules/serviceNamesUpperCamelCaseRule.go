@@ -18,6 +18,7 @@ type ServiceNamesUpperCamelCaseRule struct {
 	RuleWithSeverity
 	fixMode         bool
 	autoDisableType autodisable.PlacementType
+	env             visitor.Env
 }
 
 // NewServiceNamesUpperCamelCaseRule creates a new ServiceNamesUpperCamelCaseRule.
@@ -25,6 +26,7 @@ func NewServiceNamesUpperCamelCaseRule(
 	severity rule.Severity,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) ServiceNamesUpperCamelCaseRule {
 	if autoDisableType != autodisable.Noop {
 		fixMode = false
@@ -33,6 +35,7 @@ func NewServiceNamesUpperCamelCaseRule(
 		RuleWithSeverity: RuleWithSeverity{severity: severity},
 		fixMode:          fixMode,
 		autoDisableType:  autoDisableType,
+		env:              env,
 	}
 }
 
@@ -46,14 +49,30 @@ func (r ServiceNamesUpperCamelCaseRule) Purpose() string {
 	return "Verifies that all service names are CamelCase (with an initial capital)."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r ServiceNamesUpperCamelCaseRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "The style guide names the services in CamelCase, which fits the type names in the generated code.",
+		Bad: `service foo_service {
+}`,
+		Good: `service FooService {
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r ServiceNamesUpperCamelCaseRule) IsOfficial() bool {
 	return true
 }
 
+// IsFixable decides whether or not this rule can fix the failures.
+func (r ServiceNamesUpperCamelCaseRule) IsFixable() bool {
+	return true
+}
+
 // Apply applies the rule to the proto.
 func (r ServiceNamesUpperCamelCaseRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
-	base, err := visitor.NewBaseFixableVisitor(r.ID(), r.fixMode, proto, string(r.Severity()))
+	base, err := visitor.NewBaseFixableVisitorWithEnv(r.ID(), r.fixMode, r.env, proto, string(r.Severity()))
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +92,7 @@ func (v *serviceNamesUpperCamelCaseVisitor) VisitService(service *parser.Service
 	name := service.ServiceName
 	if !strs.IsUpperCamelCase(name) {
 		expected := strs.ToUpperCamelCase(name)
-		v.AddFailuref(service.Meta.Pos, "Service name %q must be UpperCamelCase like %q", name, expected)
+		v.AddFailureAtNamef(service.Meta.Pos, service.Meta.LastPos, name, "Service name %q must be UpperCamelCase like %q", name, expected)
 
 		err := v.Fixer.SearchAndReplace(service.Meta.Pos, func(lex *lexer.Lexer) fixer.TextEdit {
 			lex.NextKeyword()
@@ -11,6 +11,7 @@ import (
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 func TestServiceNamesUpperCamelCaseRule_Apply(t *testing.T) {
@@ -98,7 +99,7 @@ func TestServiceNamesUpperCamelCaseRule_Apply(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			rule := rules.NewServiceNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop)
+			rule := rules.NewServiceNamesUpperCamelCaseRule(rule.SeverityError, false, autodisable.Noop, visitor.Env{})
 
 			got, err := rule.Apply(test.inputProto)
 			if err != nil {
@@ -133,7 +134,7 @@ func TestServiceNamesUpperCamelCaseRule_Apply_fix(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewServiceNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop)
+			r := rules.NewServiceNamesUpperCamelCaseRule(rule.SeverityError, true, autodisable.Noop, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -168,7 +169,7 @@ func TestServiceNamesUpperCamelCaseRule_Apply_disable(t *testing.T) {
 	for _, test := range tests {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
-			r := rules.NewServiceNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType)
+			r := rules.NewServiceNamesUpperCamelCaseRule(rule.SeverityError, true, test.inputPlacementType, visitor.Env{})
 			testApplyFix(t, r, test.inputFilename, test.wantFilename)
 		})
 	}
@@ -14,16 +14,19 @@ type ServicesHaveCommentRule struct {
 	// Golang style comments should begin with the name of the thing being described.
 	// See https://github.com/golang/go/wiki/CodeReviewComments#comment-sentences
 	shouldFollowGolangStyle bool
+	env                     visitor.Env
 }
 
 // NewServicesHaveCommentRule creates a new ServicesHaveCommentRule.
 func NewServicesHaveCommentRule(
 	severity rule.Severity,
 	shouldFollowGolangStyle bool,
+	env visitor.Env,
 ) ServicesHaveCommentRule {
 	return ServicesHaveCommentRule{
 		RuleWithSeverity:        RuleWithSeverity{severity: severity},
 		shouldFollowGolangStyle: shouldFollowGolangStyle,
+		env:                     env,
 	}
 }
 
@@ -37,6 +40,18 @@ func (r ServicesHaveCommentRule) Purpose() string {
 	return "Verifies that all services have a comment."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r ServicesHaveCommentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A comment on each service documents the API for the readers of the generated code.",
+		Bad: `service FooService {
+}`,
+		Good: `// FooService manages the foos.
+service FooService {
+}`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r ServicesHaveCommentRule) IsOfficial() bool {
 	return false
@@ -45,7 +60,7 @@ func (r ServicesHaveCommentRule) IsOfficial() bool {
 // Apply applies the rule to the proto.
 func (r ServicesHaveCommentRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
 	v := &servicesHaveCommentVisitor{
-		BaseAddVisitor:          visitor.NewBaseAddVisitor(r.ID(), string(r.Severity())),
+		BaseAddVisitor:          visitor.NewBaseAddVisitorWithEnv(r.ID(), string(r.Severity()), r.env),
 		shouldFollowGolangStyle: r.shouldFollowGolangStyle,
 	}
 	return visitor.RunVisitor(v, proto, r.ID())
@@ -60,9 +75,9 @@ type servicesHaveCommentVisitor struct {
 func (v *servicesHaveCommentVisitor) VisitService(service *parser.Service) bool {
 	n := service.ServiceName
 	if v.shouldFollowGolangStyle && !hasGolangStyleComment(service.Comments, n) {
-		v.AddFailuref(service.Meta.Pos, `Service %q should have a comment of the form "// %s ..."`, n, n)
+		v.AddFailureAtNamef(service.Meta.Pos, service.Meta.LastPos, n, `Service %q should have a comment of the form "// %s ..."`, n, n)
 	} else if !hasComments(service.Comments, service.InlineComment, service.InlineCommentBehindLeftCurly) {
-		v.AddFailuref(service.Meta.Pos, `Service %q should have a comment`, n)
+		v.AddFailureAtNamef(service.Meta.Pos, service.Meta.LastPos, n, `Service %q should have a comment`, n)
 	}
 	return false
 }
@@ -38,6 +38,15 @@ func (r SyntaxConsistentRule) Purpose() string {
 	return "Verifies that syntax is a specified version(default is proto3)."
 }
 
+// Documentation returns the rationale and the examples of this rule.
+func (r SyntaxConsistentRule) Documentation() rule.Documentation {
+	return rule.Documentation{
+		Rationale: "A single syntax version across the files avoids the subtle differences between proto2 and proto3 in the same project.",
+		Bad:       `syntax = "proto2";`,
+		Good:      `syntax = "proto3";`,
+	}
+}
+
 // IsOfficial decides whether or not this rule belongs to the official guide.
 func (r SyntaxConsistentRule) IsOfficial() bool {
 	return false
@@ -60,7 +69,7 @@ type syntaxConsistentVisitor struct {
 // VisitSyntax checks the syntax.
 func (v *syntaxConsistentVisitor) VisitSyntax(s *parser.Syntax) bool {
 	if s.ProtobufVersion != v.version {
-		v.AddFailuref(s.Meta.Pos, "Syntax should be %q but was %q.", v.version, s.ProtobufVersion)
+		v.AddFailureWithRangef(s.Meta.Pos, s.Meta.LastPos, "Syntax should be %q but was %q.", v.version, s.ProtobufVersion)
 	}
 	return false
 }
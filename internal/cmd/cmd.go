@@ -1,12 +1,20 @@
 package cmd
 
 import (
+	"context"
+	"flag"
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-plugin"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/initconfig"
 	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/lint"
 	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/list"
+	"github.com/maramkhaledn/protolint/internal/libinternal"
 	"github.com/maramkhaledn/protolint/internal/osutil"
 	"github.com/maramkhaledn/protolint/mcp"
 )
@@ -18,23 +26,28 @@ Protocol Buffer Linter Command.
 Usage:
 	protolint <command> [arguments]
 	protolint --version
-	protolint --mcp
+	protolint --mcp [--listen 127.0.0.1:PORT]
 
 The commands are:
 	lint     lint protocol buffer files
+	init     generate a config file from the conventions of existing files
 	list     list all current lint rules being used
 	version  print protolint version
 
 The flags are:
-	--version  print protolint version
-	-v         print protolint version (when used as the only argument)
-	--mcp      start as an MCP server
+	--version          print protolint version
+	-v                 print protolint version (when used as the only argument)
+	--mcp              start as an MCP server over stdio
+	--listen           serve MCP over HTTP at the loopback address instead, with --mcp
+	--request_timeout  limit the time of each MCP request, like 30s, with --mcp. 0 means no limit. Default is 5m
+	--plugin           load the plugin rules for list-rules and explain-rule, with --mcp. It can be repeated
 `
 )
 
 const (
 	subCmdLint    = "lint"
 	subCmdList    = "list"
+	subCmdInit    = "init"
 	subCmdVersion = "version"
 	mcpFlag       = "--mcp"
 )
@@ -49,6 +62,16 @@ func Do(
 	args []string,
 	stdout io.Writer,
 	stderr io.Writer,
+) osutil.ExitCode {
+	return DoContext(context.Background(), args, stdout, stderr)
+}
+
+// DoContext runs the command logic like Do, and stops linting when ctx is done.
+func DoContext(
+	ctx context.Context,
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
 ) osutil.ExitCode {
 	// Check for --version and --mcp flags
 	for _, arg := range args {
@@ -56,7 +79,7 @@ func Do(
 			return doVersion(stdout)
 		}
 		if arg == mcpFlag {
-			return doMCP(stdout, stderr)
+			return doMCP(args, stdout, stderr)
 		}
 	}
 
@@ -66,6 +89,7 @@ func Do(
 		return osutil.ExitInternalFailure
 	default:
 		return doSub(
+			ctx,
 			args,
 			stdout,
 			stderr,
@@ -74,23 +98,27 @@ func Do(
 }
 
 func doSub(
+	ctx context.Context,
 	args []string,
 	stdout io.Writer,
 	stderr io.Writer,
 ) osutil.ExitCode {
 	switch args[0] {
 	case subCmdLint:
-		return doLint(args[1:], stdout, stderr)
+		return doLint(ctx, args[1:], stdout, stderr)
 	case subCmdList:
 		return doList(args[1:], stdout, stderr)
+	case subCmdInit:
+		return doInit(args[1:], stdout, stderr)
 	case subCmdVersion:
 		return doVersion(stdout)
 	default:
-		return doLint(args, stdout, stderr)
+		return doLint(ctx, args, stdout, stderr)
 	}
 }
 
 func doLint(
+	ctx context.Context,
 	args []string,
 	stdout io.Writer,
 	stderr io.Writer,
@@ -126,7 +154,8 @@ func doLint(
 		}
 		return osutil.ExitInternalFailure
 	}
-	return subCmd.Run()
+	subCmd.SetFileLinted(libinternal.FileLinted(ctx))
+	return subCmd.RunContext(ctx)
 }
 
 func doList(
@@ -147,6 +176,29 @@ func doList(
 	return subCmd.Run()
 }
 
+func doInit(
+	args []string,
+	stdout io.Writer,
+	stderr io.Writer,
+) osutil.ExitCode {
+	flags, err := initconfig.NewFlags(args)
+	if err != nil {
+		_, _ = fmt.Fprint(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+
+	subCmd, err := initconfig.NewCmdInit(
+		flags,
+		stdout,
+		stderr,
+	)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	return subCmd.Run()
+}
+
 func doVersion(
 	stdout io.Writer,
 ) osutil.ExitCode {
@@ -155,9 +207,34 @@ func doVersion(
 }
 
 func doMCP(
+	args []string,
 	stdout io.Writer,
 	stderr io.Writer,
 ) osutil.ExitCode {
-	server := mcp.NewServer(stdout, stderr)
+	flags := flag.NewFlagSet("mcp", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	_ = flags.Bool("mcp", true, "start as an MCP server")
+	listen := flags.String("listen", "", "serve MCP over HTTP at the loopback address, like 127.0.0.1:8080")
+	requestTimeout := flags.Duration("request_timeout", 5*time.Minute, "time limit of each MCP request. 0 means no limit")
+	var pf subcmds.PluginFlag
+	flags.Var(&pf, "plugin", "plugins to provide custom lint rule set for list-rules and explain-rule")
+	if err := flags.Parse(args); err != nil {
+		return osutil.ExitInternalFailure
+	}
+
+	// The plugins live as long as the server, not to start them for each request.
+	defer plugin.CleanupClients()
+	plugins, err := pf.BuildPlugins(false)
+	if err != nil {
+		_, _ = fmt.Fprintln(stderr, err)
+		return osutil.ExitInternalFailure
+	}
+
+	server := mcp.NewServer(version, stdout, stderr)
+	server.SetRequestTimeout(*requestTimeout)
+	server.SetPlugins(plugins)
+	if *listen != "" {
+		return server.RunHTTP(*listen)
+	}
 	return server.Run()
 }
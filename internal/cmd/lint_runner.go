@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"io"
 
 	"github.com/maramkhaledn/protolint/internal/libinternal"
@@ -20,6 +21,11 @@ func (r *CmdLintRunner) Run(args []string, stdout, stderr io.Writer) osutil.Exit
 	return Do(args, stdout, stderr)
 }
 
+// RunContext executes the lint command, stopping it when ctx is done
+func (r *CmdLintRunner) RunContext(ctx context.Context, args []string, stdout, stderr io.Writer) osutil.ExitCode {
+	return DoContext(ctx, args, stdout, stderr)
+}
+
 // Initialize registers the cmd lint runner with the internal library
 func Initialize() {
 	libinternal.SetLintRunner(NewCmdLintRunner())
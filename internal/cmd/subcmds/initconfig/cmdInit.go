@@ -0,0 +1,163 @@
+package initconfig
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"os"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
+	"github.com/maramkhaledn/protolint/internal/linter"
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// CmdInit is an init command.
+type CmdInit struct {
+	flags      Flags
+	stdout     io.Writer
+	stderr     io.Writer
+	protoFiles []file.ProtoFile
+}
+
+// NewCmdInit creates a new CmdInit.
+func NewCmdInit(
+	flags Flags,
+	stdout io.Writer,
+	stderr io.Writer,
+) (*CmdInit, error) {
+	protoSet, err := file.NewProtoSet(flags.FilePaths)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CmdInit{
+		flags:      flags,
+		stdout:     stdout,
+		stderr:     stderr,
+		protoFiles: protoSet.ProtoFiles(),
+	}, nil
+}
+
+// Run infers the conventions from proto files and writes a config file.
+func (c *CmdInit) Run() osutil.ExitCode {
+	if !c.flags.Force {
+		if _, err := os.Stat(c.flags.OutputPath); err == nil {
+			_, _ = fmt.Fprintf(c.stderr, "%s already exists. Use -force to overwrite it\n", c.flags.OutputPath)
+			return osutil.ExitInternalFailure
+		}
+	}
+
+	data, err := c.run()
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+
+	err = os.WriteFile(c.flags.OutputPath, data, 0644)
+	if err != nil {
+		_, _ = fmt.Fprintln(c.stderr, err)
+		return osutil.ExitInternalFailure
+	}
+	_, _ = fmt.Fprintf(c.stdout, "protolint wrote a config file to %s\n", c.flags.OutputPath)
+	return osutil.ExitSuccess
+}
+
+func (c *CmdInit) run() ([]byte, error) {
+	protos := make(map[string]*parser.Proto)
+	inferrer := newInferrer()
+	for _, f := range c.protoFiles {
+		content, err := os.ReadFile(f.Path())
+		if err != nil {
+			return nil, err
+		}
+		inferrer.addContent(string(content))
+
+		proto, err := f.Parse(c.flags.Verbose)
+		if err != nil {
+			if c.flags.Verbose {
+				log.Printf("[INFO] protolint skips inferring from %s: %v\n", f.DisplayPath(), err)
+			}
+			continue
+		}
+		inferrer.addProto(proto)
+		protos[f.DisplayPath()] = proto
+	}
+
+	conventions := inferrer.conventions()
+	if c.flags.Verbose {
+		log.Printf("[INFO] protolint infers the conventions: %+v\n", conventions)
+	}
+	generated := newGeneratedConfig(conventions)
+
+	if c.flags.AddIgnores {
+		externalConfig, err := generated.toExternalConfig()
+		if err != nil {
+			return nil, err
+		}
+		filesByRuleID, err := c.collectFailingFiles(externalConfig, protos)
+		if err != nil {
+			return nil, err
+		}
+		generated.addIgnores(filesByRuleID)
+	}
+
+	// Make sure that protolint can load the generated config.
+	if _, err := generated.toExternalConfig(); err != nil {
+		return nil, err
+	}
+	return generated.marshal()
+}
+
+// collectFailingFiles lints the parsed files under the externalConfig and
+// returns the files which have any failures, grouped by the rule ID.
+func (c *CmdInit) collectFailingFiles(
+	externalConfig config.ExternalConfig,
+	protos map[string]*parser.Proto,
+) (map[string][]string, error) {
+	allRules, err := subcmds.NewAllRules(externalConfig.Lint.RulesOption, false, autodisable.Noop, visitor.Env{}, c.flags.Verbose, nil)
+	if err != nil {
+		return nil, err
+	}
+	defaultRuleIDs := allRules.Default().IDs()
+
+	filesByRuleID := make(map[string][]string)
+	l := linter.NewLinter()
+	for _, f := range c.protoFiles {
+		proto, ok := protos[f.DisplayPath()]
+		if !ok {
+			continue
+		}
+
+		var rs []rule.HasApply
+		for _, r := range allRules {
+			if externalConfig.ShouldSkipRule(r.ID(), f.DisplayPath(), defaultRuleIDs) {
+				continue
+			}
+			rs = append(rs, r)
+		}
+
+		failures, err := l.Run(func(*parser.Proto) (*parser.Proto, error) {
+			return proto, nil
+		}, rs)
+		if err != nil {
+			return nil, err
+		}
+
+		seen := make(map[string]bool)
+		for _, failure := range failures {
+			if seen[failure.RuleID()] {
+				continue
+			}
+			seen[failure.RuleID()] = true
+			filesByRuleID[failure.RuleID()] = append(filesByRuleID[failure.RuleID()], f.DisplayPath())
+		}
+	}
+	return filesByRuleID, nil
+}
@@ -0,0 +1,219 @@
+package initconfig_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/initconfig"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+)
+
+const header = `---
+# Generated by "protolint init".
+# The rules_option values follow the conventions found in the existing files.
+# Refer to https://github.com/maramkhaledn/protolint/blob/master/_example/config/.protolint.yaml
+# for the config file specification.
+`
+
+func TestCmdInit_Run(t *testing.T) {
+	conventionalPath := setting_test.TestDataPath("initconfig", "conventional")
+	customPath := setting_test.TestDataPath("initconfig", "custom")
+	tabsPath := setting_test.TestDataPath("initconfig", "tabs")
+	unevenPath := setting_test.TestDataPath("initconfig", "uneven")
+
+	tests := []struct {
+		name           string
+		inputArgs      []string
+		wantExitCode   osutil.ExitCode
+		wantConfigFile string
+	}{
+		{
+			name:         "infers the official style",
+			inputArgs:    []string{conventionalPath},
+			wantExitCode: osutil.ExitSuccess,
+			wantConfigFile: header + `lint:
+  rules:
+    add:
+    - SERVICE_NAMES_END_WITH
+  rules_option:
+    indent:
+      style: "4"
+    quote_consistent:
+      quote: double
+    enum_field_names_zero_value_end_with:
+      suffix: UNSPECIFIED
+    service_names_end_with:
+      text: Service
+`,
+		},
+		{
+			name:         "infers the custom style",
+			inputArgs:    []string{customPath},
+			wantExitCode: osutil.ExitSuccess,
+			wantConfigFile: header + `lint:
+  rules:
+    add:
+    - RPC_NAMES_CASE
+    - SERVICE_NAMES_END_WITH
+    remove:
+    - RPC_NAMES_UPPER_CAMEL_CASE
+  rules_option:
+    indent:
+      style: "2"
+    quote_consistent:
+      quote: single
+    enum_field_names_zero_value_end_with:
+      suffix: INVALID
+    rpc_names_case:
+      convention: lower_camel_case
+    service_names_end_with:
+      text: API
+`,
+		},
+		{
+			name:         "infers tabs from the majority of the indented lines",
+			inputArgs:    []string{tabsPath},
+			wantExitCode: osutil.ExitSuccess,
+			wantConfigFile: header + `lint:
+  rules_option:
+    indent:
+      style: tab
+    quote_consistent:
+      quote: double
+    enum_field_names_zero_value_end_with:
+      suffix: UNSPECIFIED
+`,
+		},
+		{
+			name:         "infers the common step of the space indentations",
+			inputArgs:    []string{unevenPath},
+			wantExitCode: osutil.ExitSuccess,
+			wantConfigFile: header + `lint:
+  rules:
+    add:
+    - SERVICE_NAMES_END_WITH
+  rules_option:
+    indent:
+      style: "2"
+    quote_consistent:
+      quote: double
+    service_names_end_with:
+      text: Service
+`,
+		},
+		{
+			name:         "adds ignores for the files which violate the inferred style",
+			inputArgs:    []string{"-add_ignores", customPath, conventionalPath},
+			wantExitCode: osutil.ExitSuccess,
+			wantConfigFile: header + `lint:
+  ignores:
+  - id: ENUM_FIELD_NAMES_ZERO_VALUE_END_WITH
+    files:
+    - ../../../../_testdata/initconfig/conventional/example.proto
+  - id: INDENT
+    files:
+    - ../../../../_testdata/initconfig/conventional/example.proto
+  - id: QUOTE_CONSISTENT
+    files:
+    - ../../../../_testdata/initconfig/custom/first.proto
+    - ../../../../_testdata/initconfig/custom/second.proto
+  - id: RPC_NAMES_CASE
+    files:
+    - ../../../../_testdata/initconfig/conventional/example.proto
+  - id: SERVICE_NAMES_END_WITH
+    files:
+    - ../../../../_testdata/initconfig/conventional/example.proto
+  rules:
+    add:
+    - RPC_NAMES_CASE
+    - SERVICE_NAMES_END_WITH
+    remove:
+    - RPC_NAMES_UPPER_CAMEL_CASE
+  rules_option:
+    indent:
+      style: "2"
+    quote_consistent:
+      quote: double
+    enum_field_names_zero_value_end_with:
+      suffix: INVALID
+    rpc_names_case:
+      convention: lower_camel_case
+    service_names_end_with:
+      text: API
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			outputPath := filepath.Join(t.TempDir(), ".protolint.yaml")
+			args := append([]string{"-output", outputPath}, test.inputArgs...)
+
+			flags, err := initconfig.NewFlags(args)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			cmd, err := initconfig.NewCmdInit(flags, &bytes.Buffer{}, &bytes.Buffer{})
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			got := cmd.Run()
+			if got != test.wantExitCode {
+				t.Errorf("got exit code %v, but want %v", got, test.wantExitCode)
+			}
+
+			content, err := os.ReadFile(outputPath)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			if string(content) != test.wantConfigFile {
+				t.Errorf("got %s, but want %s", content, test.wantConfigFile)
+			}
+		})
+	}
+}
+
+func TestCmdInit_Run_refusesToOverwrite(t *testing.T) {
+	outputPath := filepath.Join(t.TempDir(), ".protolint.yaml")
+	err := os.WriteFile(outputPath, []byte("lint:\n"), 0644)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	flags, err := initconfig.NewFlags([]string{
+		"-output", outputPath,
+		setting_test.TestDataPath("initconfig", "custom"),
+	})
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	stderr := &bytes.Buffer{}
+	cmd, err := initconfig.NewCmdInit(flags, &bytes.Buffer{}, stderr)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	got := cmd.Run()
+	if got != osutil.ExitInternalFailure {
+		t.Errorf("got exit code %v, but want %v", got, osutil.ExitInternalFailure)
+	}
+	content, err := os.ReadFile(outputPath)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if string(content) != "lint:\n" {
+		t.Errorf("got %s, but the file must not be overwritten", content)
+	}
+}
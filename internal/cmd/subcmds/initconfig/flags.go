@@ -0,0 +1,62 @@
+package initconfig
+
+import (
+	"flag"
+)
+
+const (
+	defaultOutputPath = ".protolint.yaml"
+)
+
+// Flags represents a set of init flag parameters.
+type Flags struct {
+	*flag.FlagSet
+
+	FilePaths  []string
+	OutputPath string
+	AddIgnores bool
+	Force      bool
+	Verbose    bool
+}
+
+// NewFlags creates a new Flags.
+func NewFlags(
+	args []string,
+) (Flags, error) {
+	f := Flags{
+		FlagSet: flag.NewFlagSet("init", flag.ExitOnError),
+	}
+
+	f.StringVar(
+		&f.OutputPath,
+		"output",
+		defaultOutputPath,
+		"path/to/protolint.yaml to write the inferred config to",
+	)
+	f.BoolVar(
+		&f.AddIgnores,
+		"add_ignores",
+		false,
+		"adds ignores entries for the rules with existing violations so that the linting starts green",
+	)
+	f.BoolVar(
+		&f.Force,
+		"force",
+		false,
+		"overwrites the output file if it already exists",
+	)
+	f.BoolVar(
+		&f.Verbose,
+		"v",
+		false,
+		"verbose output that includes parsing process details",
+	)
+
+	_ = f.Parse(args)
+
+	f.FilePaths = f.Args()
+	if len(f.FilePaths) == 0 {
+		f.FilePaths = []string{"."}
+	}
+	return f, nil
+}
@@ -0,0 +1,142 @@
+package initconfig
+
+import (
+	"bytes"
+	"sort"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+)
+
+const generatedConfigHeader = `---
+# Generated by "protolint init".
+# The rules_option values follow the conventions found in the existing files.
+# Refer to https://github.com/maramkhaledn/protolint/blob/master/_example/config/.protolint.yaml
+# for the config file specification.
+`
+
+// generatedConfig represents the yaml document written by the init command.
+// It mirrors the user-facing notation of config.ExternalConfig, which
+// differs from its internal representation for some rule options.
+type generatedConfig struct {
+	Lint generatedLint `yaml:"lint"`
+}
+
+type generatedLint struct {
+	Ignores     []generatedIgnore    `yaml:"ignores,omitempty"`
+	Rules       *generatedRules      `yaml:"rules,omitempty"`
+	RulesOption generatedRulesOption `yaml:"rules_option,omitempty"`
+}
+
+type generatedIgnore struct {
+	ID    string   `yaml:"id"`
+	Files []string `yaml:"files"`
+}
+
+type generatedRules struct {
+	Add    []string `yaml:"add,omitempty"`
+	Remove []string `yaml:"remove,omitempty"`
+}
+
+type generatedRulesOption struct {
+	Indent                         *generatedIndent                         `yaml:"indent,omitempty"`
+	QuoteConsistent                *generatedQuoteConsistent                `yaml:"quote_consistent,omitempty"`
+	EnumFieldNamesZeroValueEndWith *generatedEnumFieldNamesZeroValueEndWith `yaml:"enum_field_names_zero_value_end_with,omitempty"`
+	RPCNamesCase                   *generatedRPCNamesCase                   `yaml:"rpc_names_case,omitempty"`
+	ServiceNamesEndWith            *generatedServiceNamesEndWith            `yaml:"service_names_end_with,omitempty"`
+}
+
+type generatedIndent struct {
+	Style string `yaml:"style"`
+}
+
+type generatedQuoteConsistent struct {
+	Quote string `yaml:"quote"`
+}
+
+type generatedEnumFieldNamesZeroValueEndWith struct {
+	Suffix string `yaml:"suffix"`
+}
+
+type generatedRPCNamesCase struct {
+	Convention string `yaml:"convention"`
+}
+
+type generatedServiceNamesEndWith struct {
+	Text string `yaml:"text"`
+}
+
+// newGeneratedConfig creates a generatedConfig following the conventions.
+func newGeneratedConfig(c Conventions) generatedConfig {
+	var g generatedConfig
+	var rules generatedRules
+	option := &g.Lint.RulesOption
+
+	if c.IndentStyle != "" {
+		option.Indent = &generatedIndent{Style: c.IndentStyle}
+	}
+	if c.Quote != "" {
+		option.QuoteConsistent = &generatedQuoteConsistent{Quote: c.Quote}
+	}
+	if c.ZeroValueSuffix != "" {
+		option.EnumFieldNamesZeroValueEndWith = &generatedEnumFieldNamesZeroValueEndWith{Suffix: c.ZeroValueSuffix}
+	}
+	if c.RPCNameConvention != conventionUpperCamel {
+		option.RPCNamesCase = &generatedRPCNamesCase{Convention: c.RPCNameConvention}
+		rules.Add = append(rules.Add, "RPC_NAMES_CASE")
+		rules.Remove = append(rules.Remove, "RPC_NAMES_UPPER_CAMEL_CASE")
+	}
+	if c.ServiceSuffix != "" {
+		option.ServiceNamesEndWith = &generatedServiceNamesEndWith{Text: c.ServiceSuffix}
+		rules.Add = append(rules.Add, "SERVICE_NAMES_END_WITH")
+	}
+
+	if 0 < len(rules.Add) || 0 < len(rules.Remove) {
+		g.Lint.Rules = &rules
+	}
+	return g
+}
+
+// addIgnores adds an ignores entry to each rule with the files.
+func (g *generatedConfig) addIgnores(filesByRuleID map[string][]string) {
+	var ids []string
+	for id := range filesByRuleID {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	for _, id := range ids {
+		files := append([]string{}, filesByRuleID[id]...)
+		sort.Strings(files)
+		g.Lint.Ignores = append(g.Lint.Ignores, generatedIgnore{
+			ID:    id,
+			Files: files,
+		})
+	}
+}
+
+// marshal encodes g into the yaml document.
+func (g generatedConfig) marshal() ([]byte, error) {
+	data, err := yaml.Marshal(g)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(generatedConfigHeader), data...), nil
+}
+
+// toExternalConfig decodes g in the same way as protolint loads a config file.
+func (g generatedConfig) toExternalConfig() (config.ExternalConfig, error) {
+	data, err := g.marshal()
+	if err != nil {
+		return config.ExternalConfig{}, err
+	}
+
+	var c config.ExternalConfig
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.SetStrict(true)
+	if err := decoder.Decode(&c); err != nil {
+		return config.ExternalConfig{}, err
+	}
+	return c, nil
+}
@@ -0,0 +1,236 @@
+package initconfig
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/linter/strs"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// Indent styles which the INDENT rule accepts.
+const (
+	indentStyleTab = "tab"
+	indentStyle2   = "2"
+	indentStyle4   = "4"
+)
+
+// Quote types which the QUOTE_CONSISTENT rule accepts.
+const (
+	quoteDouble = "double"
+	quoteSingle = "single"
+)
+
+// Conventions which the RPC_NAMES_CASE rule accepts.
+// An empty convention means UpperCamelCase, the official style.
+const (
+	conventionUpperCamel = ""
+	conventionLowerCamel = "lower_camel_case"
+	conventionUpperSnake = "upper_snake_case"
+	conventionLowerSnake = "lower_snake_case"
+)
+
+// votes counts the occurrences of each convention.
+type votes map[string]int
+
+// winner returns the most voted convention.
+// Ties are broken by the lexical order to keep the result stable.
+func (v votes) winner() (string, bool) {
+	var keys []string
+	for k := range v {
+		keys = append(keys, k)
+	}
+	if len(keys) == 0 {
+		return "", false
+	}
+	sort.Strings(keys)
+
+	best := keys[0]
+	for _, k := range keys[1:] {
+		if v[best] < v[k] {
+			best = k
+		}
+	}
+	return best, true
+}
+
+// Conventions represents the conventions inferred from the existing files.
+// An empty field means that there was nothing to infer it from.
+type Conventions struct {
+	IndentStyle       string
+	Quote             string
+	ZeroValueSuffix   string
+	RPCNameConvention string
+	ServiceSuffix     string
+}
+
+// inferrer accumulates the observations across files.
+type inferrer struct {
+	indents         votes
+	quotes          votes
+	zeroSuffixes    votes
+	rpcConventions  votes
+	serviceSuffixes votes
+}
+
+func newInferrer() *inferrer {
+	return &inferrer{
+		indents:         make(votes),
+		quotes:          make(votes),
+		zeroSuffixes:    make(votes),
+		rpcConventions:  make(votes),
+		serviceSuffixes: make(votes),
+	}
+}
+
+// addContent observes the raw content of a file.
+func (i *inferrer) addContent(content string) {
+	if style, ok := inferIndentStyle(content); ok {
+		i.indents[style]++
+	}
+}
+
+// addProto observes the parsed file.
+func (i *inferrer) addProto(proto *parser.Proto) {
+	proto.Accept(&inferrerVisitor{inferrer: i})
+}
+
+// conventions returns the majority of each observation.
+func (i *inferrer) conventions() Conventions {
+	var c Conventions
+	c.IndentStyle, _ = i.indents.winner()
+	c.Quote, _ = i.quotes.winner()
+	c.ZeroValueSuffix, _ = i.zeroSuffixes.winner()
+	c.RPCNameConvention, _ = i.rpcConventions.winner()
+	c.ServiceSuffix, _ = i.serviceSuffixes.winner()
+	return c
+}
+
+// inferIndentStyle guesses the indentation unit from the leading whitespaces.
+// The lines indented with tabs are tallied against the lines indented with spaces,
+// and the greatest common divisor of the space indentations is considered the unit.
+func inferIndentStyle(content string) (string, bool) {
+	step := 0
+	tabs := 0
+	spaceLines := 0
+	for _, line := range strings.Split(content, "\n") {
+		trimmed := strings.TrimLeft(line, " \t")
+		if len(trimmed) == 0 || strings.HasPrefix(trimmed, "*") {
+			// Skip blank lines and the inner lines of block comments.
+			continue
+		}
+		leading := line[:len(line)-len(trimmed)]
+		if len(leading) == 0 {
+			continue
+		}
+		if strings.HasPrefix(leading, "\t") {
+			tabs++
+			continue
+		}
+		spaceLines++
+		step = gcd(step, len(leading)-len(strings.TrimLeft(leading, " ")))
+	}
+
+	switch {
+	case tabs == 0 && spaceLines == 0:
+		return "", false
+	case spaceLines < tabs:
+		return indentStyleTab, true
+	case step%4 == 0:
+		return indentStyle4, true
+	case step%2 == 0:
+		return indentStyle2, true
+	}
+	return "", false
+}
+
+// gcd returns the greatest common divisor of a and b. gcd(0, b) is b.
+func gcd(a, b int) int {
+	for b != 0 {
+		a, b = b, a%b
+	}
+	return a
+}
+
+// inferNameConvention classifies the name into one of the RPC_NAMES_CASE conventions.
+func inferNameConvention(name string) (string, bool) {
+	switch {
+	case strs.IsUpperCamelCase(name):
+		return conventionUpperCamel, true
+	case strs.IsLowerCamelCase(name):
+		return conventionLowerCamel, true
+	case strs.IsUpperSnakeCase(name):
+		return conventionUpperSnake, true
+	case strs.IsLowerSnakeCase(name):
+		return conventionLowerSnake, true
+	}
+	return "", false
+}
+
+// inferQuote returns the quote type of the quoted string.
+func inferQuote(s string) (string, bool) {
+	switch {
+	case strings.HasPrefix(s, `"`):
+		return quoteDouble, true
+	case strings.HasPrefix(s, `'`):
+		return quoteSingle, true
+	}
+	return "", false
+}
+
+type inferrerVisitor struct {
+	visitor.BaseVisitor
+	inferrer *inferrer
+}
+
+func (v *inferrerVisitor) addQuote(s string) {
+	if q, ok := inferQuote(s); ok {
+		v.inferrer.quotes[q]++
+	}
+}
+
+func (v *inferrerVisitor) VisitSyntax(s *parser.Syntax) bool {
+	v.addQuote(s.ProtobufVersionQuote)
+	return false
+}
+
+func (v *inferrerVisitor) VisitImport(i *parser.Import) bool {
+	v.addQuote(i.Location)
+	return false
+}
+
+func (v *inferrerVisitor) VisitOption(o *parser.Option) bool {
+	v.addQuote(o.Constant)
+	return false
+}
+
+func (v *inferrerVisitor) VisitEnum(e *parser.Enum) bool {
+	for _, body := range e.EnumBody {
+		field, ok := body.(*parser.EnumField)
+		if !ok || field.Number != "0" {
+			continue
+		}
+		words := strs.SplitSnakeCaseWord(field.Ident)
+		if 1 < len(words) {
+			v.inferrer.zeroSuffixes[words[len(words)-1]]++
+		}
+	}
+	return true
+}
+
+func (v *inferrerVisitor) VisitService(s *parser.Service) bool {
+	words := strs.SplitCamelCaseWord(s.ServiceName)
+	if 1 < len(words) {
+		v.inferrer.serviceSuffixes[words[len(words)-1]]++
+	}
+	return true
+}
+
+func (v *inferrerVisitor) VisitRPC(r *parser.RPC) bool {
+	if c, ok := inferNameConvention(r.RPCName); ok {
+		v.inferrer.rpcConventions[c]++
+	}
+	return false
+}
@@ -1,9 +1,11 @@
 package lint
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"log"
+	"os"
 	"path/filepath"
 
 	"github.com/hashicorp/go-plugin"
@@ -14,8 +16,13 @@ import (
 
 	"github.com/maramkhaledn/protolint/internal/linter"
 	"github.com/maramkhaledn/protolint/internal/linter/file"
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
 	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 // CmdLint is a lint command.
@@ -26,6 +33,15 @@ type CmdLint struct {
 	protoFiles []file.ProtoFile
 	config     CmdLintConfig
 	output     io.Writer
+	importer   *symbol.Importer
+	// fixRecorder is nil unless the fixes are reported.
+	fixRecorder *internalreport.FixRecorder
+	// fixCounter is nil unless the fixes applied with -fix are reported.
+	fixCounter *internalreport.FixCounter
+	// fileRules are the rules applied to each file of protoFiles in the run.
+	fileRules [][]rule.HasApply
+	// fileLinted is called after linting each file if it's not nil.
+	fileLinted func(path string, linted, total int)
 }
 
 // NewCmdLint creates a new CmdLint.
@@ -39,7 +55,13 @@ func NewCmdLint(
 		return nil, err
 	}
 
-	externalConfig, err := config.GetExternalConfig(flags.ConfigPath, flags.ConfigDirPath)
+	settings, err := config.SettingsFromEnv(os.Environ())
+	if err != nil {
+		return nil, err
+	}
+	settings = append(settings, flags.Settings...)
+
+	externalConfig, err := config.GetExternalConfigWithSettings(flags.ConfigPath, flags.ConfigDirPath, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -60,6 +82,13 @@ func NewCmdLint(
 
 	output := stderr
 
+	protoPaths := flags.ProtoPaths
+	if len(protoPaths) == 0 {
+		protoPaths = symbol.DefaultImportPaths
+	}
+	importer := symbol.NewImporter(protoPaths)
+	importer.SetLintedFiles(protoSet.ProtoFiles())
+
 	return &CmdLint{
 		l:          linter.NewLinter(),
 		stdout:     stdout,
@@ -67,41 +96,89 @@ func NewCmdLint(
 		protoFiles: protoSet.ProtoFiles(),
 		config:     lintConfig,
 		output:     output,
+		importer:   importer,
 	}, nil
 }
 
+// SetFileLinted makes the run call fileLinted after linting each file with the path of the file,
+// the number of the files linted so far and the number of all the files. Nil calls nothing.
+func (c *CmdLint) SetFileLinted(fileLinted func(path string, linted, total int)) {
+	c.fileLinted = fileLinted
+}
+
 // Run lints to proto files.
 func (c *CmdLint) Run() osutil.ExitCode {
-	defer plugin.CleanupClients()
+	return c.RunContext(context.Background())
+}
 
-	failures, err := c.run()
+// RunContext lints to proto files like Run, and stops before the next rule when ctx is done.
+// The fixes made by the rules already applied are kept.
+func (c *CmdLint) RunContext(ctx context.Context) osutil.ExitCode {
+	// The MCP server lints in the same process with its own plugins,
+	// so only the run which started the plugins cleans them up.
+	if 0 < len(c.config.plugins) {
+		defer plugin.CleanupClients()
+	}
+
+	if c.config.reporters.NeedsFullRunInfo() && !c.config.fixMode && c.config.autoDisableType == autodisable.Noop {
+		c.fixRecorder = internalreport.NewFixRecorder()
+	}
+	if c.config.reporters.NeedsRunInfo() && c.config.fixMode {
+		c.fixCounter = internalreport.NewFixCounter()
+	}
+
+	failures, err := c.run(ctx)
 	if err != nil {
 		_, _ = fmt.Fprintln(c.stderr, err)
 		return osutil.ExitInternalFailure
 	}
 
-	err = c.config.reporters.ReportWithFallback(c.output, failures)
+	exitCode := c.config.ExitCode(failures)
+
+	var info internalreport.RunInfo
+	if c.config.reporters.NeedsRunInfo() {
+		info, err = c.runInfo(failures, exitCode, c.config.reporters.NeedsFullRunInfo())
+		if err != nil {
+			_, _ = fmt.Fprintln(c.stderr, err)
+			return osutil.ExitInternalFailure
+		}
+	}
+
+	err = c.config.reporters.ReportWithRunInfo(c.output, failures, info)
 	if err != nil {
 		_, _ = fmt.Fprintln(c.stderr, err)
 		return osutil.ExitInternalFailure
 	}
 
-	if 0 < len(failures) {
-		return osutil.ExitLintFailure
-	}
-
-	return osutil.ExitSuccess
+	return exitCode
 }
 
-func (c *CmdLint) run() ([]report.Failure, error) {
+func (c *CmdLint) run(ctx context.Context) ([]report.Failure, error) {
 	var allFailures []report.Failure
 
-	for _, f := range c.protoFiles {
-		failures, err := c.runOneFile(f)
+	for i, f := range c.protoFiles {
+		// Gen rules first
+		// If there is no rule, we can skip parse proto file
+		// The rules of the file share the files so that they read the file once.
+		rs, err := c.config.GenRules(f, visitor.Env{
+			Files:       osutil.NewFiles(),
+			FixRecorder: c.fixRecorder,
+			FixCounter:  c.fixCounter,
+		})
+		if err != nil {
+			return nil, err
+		}
+		c.fileRules = append(c.fileRules, rs)
+
+		failures, err := c.runOneFile(ctx, f, rs)
 		if err != nil {
 			return nil, err
 		}
 		allFailures = append(allFailures, failures...)
+
+		if c.fileLinted != nil {
+			c.fileLinted(f.DisplayPath(), i+1, len(c.protoFiles))
+		}
 	}
 	return allFailures, nil
 }
@@ -116,19 +193,20 @@ func (p ParseError) Error() string {
 }
 
 func (c *CmdLint) runOneFile(
+	ctx context.Context,
 	f file.ProtoFile,
+	rs []rule.HasApply,
 ) ([]report.Failure, error) {
-	// Gen rules first
-	// If there is no rule, we can skip parse proto file
-	rs, err := c.config.GenRules(f)
-	if err != nil {
-		return nil, err
-	}
 	if len(rs) == 0 {
 		return []report.Failure{}, nil
 	}
 
-	return c.l.Run(func(p *parser.Proto) (*parser.Proto, error) {
+	return c.l.RunWithImporter(func(p *parser.Proto) (*parser.Proto, error) {
+		// The proto is generated before each rule.
+		if err := context.Cause(ctx); err != nil {
+			return nil, err
+		}
+
 		// Recreate a protoFile if the previous rule changed the filename.
 		if p != nil && p.Meta.Filename != f.DisplayPath() {
 			newFilename := p.Meta.Filename
@@ -144,5 +222,5 @@ func (c *CmdLint) runOneFile(
 			return nil, ParseError{Message: fmt.Sprintf("%s. Use -v for more details", err)}
 		}
 		return proto, nil
-	}, rs)
+	}, c.importer, rs)
 }
@@ -6,8 +6,11 @@ import (
 	"github.com/maramkhaledn/protolint/internal/linter/config"
 	"github.com/maramkhaledn/protolint/internal/linter/file"
 	"github.com/maramkhaledn/protolint/internal/linter/report"
+	internalreporters "github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	internalrule "github.com/maramkhaledn/protolint/internal/linter/rule"
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 // CmdLintConfig is a config for lint command.
@@ -18,6 +21,7 @@ type CmdLintConfig struct {
 	verbose         bool
 	reporters       report.ReportersWithOutput
 	plugins         []shared.RuleSet
+	failOn          FailOn
 }
 
 // NewCmdLintConfig creates a new CmdLintConfig.
@@ -37,6 +41,9 @@ func NewCmdLintConfig(
 		r := *report.NewReporterWithOutput(additionalReporter.reporter, additionalReporter.targetFile)
 		reporters = append(reporters, r)
 	}
+	if flags.Stats {
+		reporters = append(reporters, *report.NewReporterWithOutput(internalreporters.SummaryReporter{}, report.WriteToConsole))
+	}
 
 	return CmdLintConfig{
 		external:        externalConfig,
@@ -45,17 +52,26 @@ func NewCmdLintConfig(
 		verbose:         flags.Verbose,
 		reporters:       reporters,
 		plugins:         flags.Plugins,
+		failOn:          flags.FailOn,
 	}
 }
 
 // GenRules generates rules which are applied to the filename path.
 func (c CmdLintConfig) GenRules(
 	f file.ProtoFile,
+	env visitor.Env,
 ) ([]rule.HasApply, error) {
-	allRules, err := subcmds.NewAllRules(c.external.Lint.RulesOption, c.fixMode, c.autoDisableType, c.verbose, c.plugins)
+	option, err := c.external.RulesOptionFor(f.DisplayPath())
+	if err != nil {
+		return nil, err
+	}
+	allRules, err := subcmds.NewAllRules(option, c.fixMode, c.autoDisableType, env, c.verbose, c.plugins)
 	if err != nil {
 		return nil, err
 	}
+	if err := c.external.ValidateOverrideRuleIDs(allRules.IDs()); err != nil {
+		return nil, err
+	}
 
 	var defaultRuleIDs []string
 	if c.external.Lint.Rules.AllDefault {
@@ -69,6 +85,9 @@ func (c CmdLintConfig) GenRules(
 		if c.external.ShouldSkipRule(r.ID(), f.DisplayPath(), defaultRuleIDs) {
 			continue
 		}
+		if severity, ok := c.external.SeverityFor(r.ID(), f.DisplayPath()); ok {
+			r = internalrule.NewSeverityOverriddenRule(r, severity)
+		}
 		hasApplies = append(hasApplies, r)
 	}
 
@@ -0,0 +1,188 @@
+package lint_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/lint"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+)
+
+func TestNewFlags_ProtoPaths(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputArgs      []string
+		wantProtoPaths []string
+	}{
+		{
+			name:      "no proto paths",
+			inputArgs: []string{"a.proto"},
+		},
+		{
+			name:           "repeated -I and -proto_path",
+			inputArgs:      []string{"-I", "a", "-proto_path=b", "--proto_path", "c", "a.proto"},
+			wantProtoPaths: []string{"a", "b", "c"},
+		},
+		{
+			name:           "list of proto paths",
+			inputArgs:      []string{"-I", "a" + string(os.PathListSeparator) + "b", "a.proto"},
+			wantProtoPaths: []string{"a", "b"},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			flags, err := lint.NewFlags(test.inputArgs)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			if !reflect.DeepEqual(flags.ProtoPaths, test.wantProtoPaths) {
+				t.Errorf("got %v, but want %v", flags.ProtoPaths, test.wantProtoPaths)
+			}
+		})
+	}
+}
+
+func TestCmdLint_Run_ProtoPaths(t *testing.T) {
+	root := setting_test.TestDataPath("imports")
+	path := setting_test.TestDataPath("imports", "foo", "v1", "foo.proto")
+
+	tests := []struct {
+		name             string
+		inputArgs        []string
+		wantImportErrors int
+	}{
+		{
+			name:             "imports searched in the current directory by default",
+			wantImportErrors: 2,
+		},
+		{
+			name:             "imports searched in the proto path",
+			inputArgs:        []string{"-I", root},
+			wantImportErrors: 1,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			args := append([]string{
+				"-set", "rules.no_default=true",
+				"-set", "rules.add=[REFERENCES_RESOLVED]",
+			}, test.inputArgs...)
+			flags, err := lint.NewFlags(append(args, path))
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			cmd, err := lint.NewCmdLint(flags, stdout, stderr)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			if got := cmd.Run(); got != osutil.ExitLintFailure {
+				t.Errorf("got exit code %v, but want %v: %s", got, osutil.ExitLintFailure, stderr)
+			}
+			if got := strings.Count(stderr.String(), "is not found in the import paths"); got != test.wantImportErrors {
+				t.Errorf("got %d import errors, but want %d: %s", got, test.wantImportErrors, stderr)
+			}
+		})
+	}
+}
+
+func TestCmdLint_RunContext_Cancelled(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "foo.proto")
+	if err := os.WriteFile(path, []byte(runInfoProto), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	flags, err := lint.NewFlags([]string{"-fix", path})
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd, err := lint.NewCmdLint(flags, stdout, stderr)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if got := cmd.RunContext(ctx); got != osutil.ExitInternalFailure {
+		t.Errorf("got exit code %v, but want %v", got, osutil.ExitInternalFailure)
+	}
+	if !strings.Contains(stderr.String(), context.Canceled.Error()) {
+		t.Errorf("got %s, but want the cancel", stderr)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if string(content) != runInfoProto {
+		t.Errorf("got the fixed file %s, but want no rule applied", content)
+	}
+}
+
+func TestCmdLint_RunContext_FileLinted(t *testing.T) {
+	dir := t.TempDir()
+	var paths []string
+	for _, name := range []string{"a.proto", "b.proto", "c.proto"} {
+		path := filepath.Join(dir, name)
+		content := "syntax = \"proto3\";\n\nmessage Foo {\n  string FieldA = 1;\n  string FieldB = 2;\n}\n"
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Errorf("got err %v", err)
+			return
+		}
+		paths = append(paths, path)
+	}
+
+	flags, err := lint.NewFlags(append([]string{
+		"-set", "rules.no_default=true",
+		"-set", "rules.add=[FIELD_NAMES_LOWER_SNAKE_CASE]",
+		"-set", "rules_option.field_names_lower_snake_case.severity=warning",
+		"-set", "max_warnings=5",
+	}, paths...))
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd, err := lint.NewCmdLint(flags, stdout, stderr)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	var linted []string
+	cmd.SetFileLinted(func(path string, n, total int) {
+		linted = append(linted, fmt.Sprintf("%s %d/%d", filepath.Base(path), n, total))
+	})
+
+	// The 2 warnings of each file add up over max_warnings.
+	if got := cmd.RunContext(context.Background()); got != osutil.ExitLintWarningFailure {
+		t.Errorf("got exit code %v, but want %v: %s", got, osutil.ExitLintWarningFailure, stderr)
+	}
+	want := []string{"a.proto 1/3", "b.proto 2/3", "c.proto 3/3"}
+	if !reflect.DeepEqual(linted, want) {
+		t.Errorf("got %v, but want %v", linted, want)
+	}
+}
@@ -0,0 +1,66 @@
+package lint
+
+import (
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// ExitCode decides the exit code from the failures according to fail_on and max_warnings.
+//
+// The precedence is:
+//  1. fail_on never always succeeds, even with max_warnings.
+//  2. Any error fails with ExitLintFailure.
+//  3. The warnings above max_warnings fail with ExitLintWarningFailure. Without max_warnings,
+//     fail_on warning and note tolerate no warnings and fail_on error tolerates any.
+//  4. Any note fails with ExitLintWarningFailure under fail_on note.
+//
+// Without both fail_on and max_warnings, any failure fails with ExitLintFailure.
+func (c CmdLintConfig) ExitCode(
+	failures []report.Failure,
+) osutil.ExitCode {
+	maxWarnings := c.external.Lint.MaxWarnings
+	if c.failOn == FailOnDefault && maxWarnings == nil {
+		if 0 < len(failures) {
+			return osutil.ExitLintFailure
+		}
+		return osutil.ExitSuccess
+	}
+
+	failOn := c.failOn
+	if failOn == FailOnDefault {
+		failOn = FailOnError
+	}
+	if failOn == FailOnNever {
+		return osutil.ExitSuccess
+	}
+
+	counts := make(map[rule.Severity]int)
+	for _, f := range failures {
+		counts[severityOf(f)]++
+	}
+
+	if 0 < counts[rule.SeverityError] {
+		return osutil.ExitLintFailure
+	}
+
+	failsOnWarnings := failOn == FailOnWarning || failOn == FailOnNote
+	switch {
+	case maxWarnings != nil && *maxWarnings < counts[rule.SeverityWarning]:
+		return osutil.ExitLintWarningFailure
+	case maxWarnings == nil && failsOnWarnings && 0 < counts[rule.SeverityWarning]:
+		return osutil.ExitLintWarningFailure
+	case failOn == FailOnNote && 0 < counts[rule.SeverityNote]:
+		return osutil.ExitLintWarningFailure
+	}
+	return osutil.ExitSuccess
+}
+
+// severityOf returns the severity of the failure. Unknown severities are regarded as errors.
+func severityOf(f report.Failure) rule.Severity {
+	switch s := rule.Severity(f.Severity()); s {
+	case rule.SeverityNote, rule.SeverityWarning:
+		return s
+	}
+	return rule.SeverityError
+}
@@ -0,0 +1,162 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/lint"
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestCmdLintConfig_ExitCode(t *testing.T) {
+	failure := func(severity rule.Severity) report.Failure {
+		return report.Failuref(meta.Position{Filename: "example.proto", Line: 1, Column: 1}, "ENUM_NAMES_UPPER_CAMEL_CASE", string(severity), "message")
+	}
+	one := 1
+
+	for _, test := range []struct {
+		name             string
+		inputFailOn      lint.FailOn
+		inputMaxWarnings *int
+		inputFailures    []report.Failure
+		wantExitCode     osutil.ExitCode
+	}{
+		{
+			name:         "no failures",
+			wantExitCode: osutil.ExitSuccess,
+		},
+		{
+			name:          "any failure fails by default",
+			inputFailures: []report.Failure{failure(rule.SeverityNote)},
+			wantExitCode:  osutil.ExitLintFailure,
+		},
+		{
+			name:          "fail_on error ignores warnings",
+			inputFailOn:   lint.FailOnError,
+			inputFailures: []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityNote)},
+			wantExitCode:  osutil.ExitSuccess,
+		},
+		{
+			name:          "fail_on error fails on errors",
+			inputFailOn:   lint.FailOnError,
+			inputFailures: []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityError)},
+			wantExitCode:  osutil.ExitLintFailure,
+		},
+		{
+			name:          "fail_on warning distinguishes only warnings",
+			inputFailOn:   lint.FailOnWarning,
+			inputFailures: []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityNote)},
+			wantExitCode:  osutil.ExitLintWarningFailure,
+		},
+		{
+			name:          "fail_on warning ignores notes",
+			inputFailOn:   lint.FailOnWarning,
+			inputFailures: []report.Failure{failure(rule.SeverityNote)},
+			wantExitCode:  osutil.ExitSuccess,
+		},
+		{
+			name:          "fail_on note fails on notes",
+			inputFailOn:   lint.FailOnNote,
+			inputFailures: []report.Failure{failure(rule.SeverityNote)},
+			wantExitCode:  osutil.ExitLintWarningFailure,
+		},
+		{
+			name:          "fail_on never ignores errors",
+			inputFailOn:   lint.FailOnNever,
+			inputFailures: []report.Failure{failure(rule.SeverityError)},
+			wantExitCode:  osutil.ExitSuccess,
+		},
+		{
+			name:             "max_warnings tolerates warnings up to the number",
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityNote)},
+			wantExitCode:     osutil.ExitSuccess,
+		},
+		{
+			name:             "max_warnings fails above the number",
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityWarning)},
+			wantExitCode:     osutil.ExitLintWarningFailure,
+		},
+		{
+			name:             "max_warnings still fails on errors",
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityError)},
+			wantExitCode:     osutil.ExitLintFailure,
+		},
+		{
+			name:             "fail_on never ignores max_warnings",
+			inputFailOn:      lint.FailOnNever,
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityError), failure(rule.SeverityWarning), failure(rule.SeverityWarning)},
+			wantExitCode:     osutil.ExitSuccess,
+		},
+		{
+			name:             "fail_on error with max_warnings ignores notes",
+			inputFailOn:      lint.FailOnError,
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityNote), failure(rule.SeverityNote)},
+			wantExitCode:     osutil.ExitSuccess,
+		},
+		{
+			name:             "fail_on error with max_warnings fails above the number",
+			inputFailOn:      lint.FailOnError,
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityWarning)},
+			wantExitCode:     osutil.ExitLintWarningFailure,
+		},
+		{
+			name:             "fail_on warning with max_warnings tolerates warnings up to the number",
+			inputFailOn:      lint.FailOnWarning,
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityNote)},
+			wantExitCode:     osutil.ExitSuccess,
+		},
+		{
+			name:             "fail_on warning with max_warnings fails above the number",
+			inputFailOn:      lint.FailOnWarning,
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityWarning)},
+			wantExitCode:     osutil.ExitLintWarningFailure,
+		},
+		{
+			name:          "fail_on warning fails on errors with warnings",
+			inputFailOn:   lint.FailOnWarning,
+			inputFailures: []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityError)},
+			wantExitCode:  osutil.ExitLintFailure,
+		},
+		{
+			name:             "fail_on note with max_warnings still fails on notes",
+			inputFailOn:      lint.FailOnNote,
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityWarning), failure(rule.SeverityNote)},
+			wantExitCode:     osutil.ExitLintWarningFailure,
+		},
+		{
+			name:             "fail_on note with max_warnings fails on errors",
+			inputFailOn:      lint.FailOnNote,
+			inputMaxWarnings: &one,
+			inputFailures:    []report.Failure{failure(rule.SeverityNote), failure(rule.SeverityError)},
+			wantExitCode:     osutil.ExitLintFailure,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			externalConfig := config.ExternalConfig{
+				Lint: config.Lint{
+					MaxWarnings: test.inputMaxWarnings,
+				},
+			}
+			c := lint.NewCmdLintConfig(externalConfig, lint.Flags{FailOn: test.inputFailOn})
+
+			got := c.ExitCode(test.inputFailures)
+			if got != test.wantExitCode {
+				t.Errorf("got %v, but want %v", got, test.wantExitCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// FailOn represents the lowest severity which makes the lint fail.
+type FailOn string
+
+// FailOn constants.
+const (
+	// FailOnDefault fails on any failure, unless max_warnings is set.
+	FailOnDefault FailOn = ""
+	// FailOnError fails on errors.
+	FailOnError FailOn = FailOn(rule.SeverityError)
+	// FailOnWarning fails on errors and warnings.
+	FailOnWarning FailOn = FailOn(rule.SeverityWarning)
+	// FailOnNote fails on any failure.
+	FailOnNote FailOn = FailOn(rule.SeverityNote)
+	// FailOnNever never fails, even if max_warnings is exceeded.
+	FailOnNever FailOn = "never"
+)
+
+type failOnFlag struct {
+	raw    string
+	failOn FailOn
+}
+
+func (f *failOnFlag) String() string {
+	return fmt.Sprint(f.raw)
+}
+
+func (f *failOnFlag) Set(value string) error {
+	if f.failOn != FailOnDefault {
+		return fmt.Errorf("fail_on is already set")
+	}
+
+	r, err := GetFailOn(value)
+	if err != nil {
+		return err
+	}
+	f.raw = value
+	f.failOn = r
+	return nil
+}
+
+// GetFailOn returns a FailOn from the specified key.
+func GetFailOn(value string) (FailOn, error) {
+	switch r := FailOn(value); r {
+	case FailOnError, FailOnWarning, FailOnNote, FailOnNever:
+		return r, nil
+	}
+	return FailOnDefault, fmt.Errorf(`available fail_on are "error", "warning", "note" and "never"`)
+}
@@ -2,12 +2,14 @@ package lint
 
 import (
 	"flag"
+	"time"
 
 	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 
 	"github.com/maramkhaledn/protolint/internal/addon/plugin/shared"
 
+	"github.com/maramkhaledn/protolint/internal/linter/config"
 	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
 
 	"github.com/maramkhaledn/protolint/internal/linter/report"
@@ -28,6 +30,10 @@ type Flags struct {
 	NoErrorOnUnmatchedPattern bool
 	Plugins                   []shared.RuleSet
 	AdditionalReporters       reporterStreamFlags
+	FailOn                    FailOn
+	Settings                  []config.Setting
+	Stats                     bool
+	ProtoPaths                []string
 }
 
 // NewFlags creates a new Flags.
@@ -43,6 +49,9 @@ func NewFlags(
 	var af autoDisableFlag
 	var pf subcmds.PluginFlag
 	var rfs reporterStreamFlags
+	var ff failOnFlag
+	var sf settingFlags
+	var ppf protoPathFlags
 
 	f.StringVar(
 		&f.ConfigPath,
@@ -98,7 +107,37 @@ func NewFlags(
 	f.Var(
 		&rfs,
 		"add-reporter",
-		"Adds a reporter to the list of reporters to use. The format should be 'name of reporter':'Path-To_output_file'",
+		"Adds a reporter to the list of reporters to use. The format should be 'name of reporter':'Path-To_output_file'. The path can include {reporter} and {timestamp}, like reports/{reporter}.{timestamp}.json",
+	)
+
+	f.Var(
+		&ff,
+		"fail_on",
+		`the lowest severity which makes the command fail. Available fail_on are "error", "warning", "note" and "never". By default, any failure makes it fail.`,
+	)
+
+	f.Var(
+		&sf,
+		"set",
+		"sets a config value by a key path under lint, like rules_option.max_line_length.max_chars=120. It can be repeated and takes precedence over the config file and the PROTOLINT_ environment variables.",
+	)
+
+	f.BoolVar(
+		&f.Stats,
+		"stats",
+		false,
+		"prints the statistics of the run after the results, the same as -add-reporter summary:-",
+	)
+
+	f.Var(
+		&ppf,
+		"proto_path",
+		"directory in which to search for the imports, like protoc. It can be repeated and takes a list separated by the OS path list separator. The default is the current directory.",
+	)
+	f.Var(
+		&ppf,
+		"I",
+		"same as -proto_path",
 	)
 
 	_ = f.Parse(args)
@@ -106,8 +145,12 @@ func NewFlags(
 		f.Reporter = rf.reporter
 	}
 	if len(rfs) > 0 {
+		rfs.expandTargetFiles(time.Now())
 		f.AdditionalReporters = rfs
 	}
+	f.FailOn = ff.failOn
+	f.Settings = sf.settings
+	f.ProtoPaths = ppf
 	if af.autoDisableType != 0 {
 		f.AutoDisableType = af.autoDisableType
 	}
@@ -0,0 +1,18 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type protoPathFlags []string
+
+func (fs *protoPathFlags) String() string {
+	return strings.Join(*fs, string(os.PathListSeparator))
+}
+
+func (fs *protoPathFlags) Set(value string) error {
+	*fs = append(*fs, filepath.SplitList(value)...)
+	return nil
+}
@@ -2,7 +2,9 @@ package lint
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/maramkhaledn/protolint/internal/linter/report"
 	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
@@ -15,9 +17,18 @@ type reporterFlag struct {
 
 type reporterStreamFlag struct {
 	reporterFlag
-	targetFile string
+	reporterName string
+	targetFile   string
 }
 
+// Placeholders in the output file path of -add-reporter.
+const (
+	reporterPlaceholder  = "{reporter}"
+	timestampPlaceholder = "{timestamp}"
+	// timestampLayout avoids the colons which some file systems don't allow.
+	timestampLayout = "20060102T150405Z"
+)
+
 type reporterStreamFlags []reporterStreamFlag
 
 func (f *reporterStreamFlag) String() string {
@@ -44,11 +55,21 @@ func (f *reporterStreamFlag) Set(value string) error {
 
 	f.raw = value
 	f.reporter = r
+	f.reporterName = reporterName
 	f.targetFile = outputFile
 
 	return nil
 }
 
+// expandTargetFile replaces the placeholders in the output file path.
+// The timestamp is in UTC so that the paths sort in time order.
+func (f *reporterStreamFlag) expandTargetFile(now time.Time) {
+	f.targetFile = strings.NewReplacer(
+		reporterPlaceholder, f.reporterName,
+		timestampPlaceholder, now.UTC().Format(timestampLayout),
+	).Replace(f.targetFile)
+}
+
 func (fs *reporterStreamFlags) String() string {
 	var items []string
 	for _, flag := range *fs {
@@ -58,6 +79,13 @@ func (fs *reporterStreamFlags) String() string {
 	return strings.Join(items, " ")
 }
 
+// expandTargetFiles replaces the placeholders in the output file paths with the same time.
+func (fs reporterStreamFlags) expandTargetFiles(now time.Time) {
+	for i := range fs {
+		fs[i].expandTargetFile(now)
+	}
+}
+
 func (fs *reporterStreamFlags) Set(value string) error {
 	var r reporterStreamFlag
 	err := r.Set(value)
@@ -87,25 +115,51 @@ func (f *reporterFlag) Set(value string) error {
 	return nil
 }
 
+const (
+	// templateReporterPrefix precedes the path of the template file, like template=path/to/file.tmpl.
+	templateReporterPrefix = "template="
+	// markdownReporterPrefix precedes the max size of the report in bytes, like markdown=30000.
+	markdownReporterPrefix = "markdown="
+)
+
 // GetReporter returns a reporter from the specified key.
 func GetReporter(value string) (report.Reporter, error) {
+	if strings.HasPrefix(value, templateReporterPrefix) {
+		return reporters.NewTemplateReporter(strings.TrimPrefix(value, templateReporterPrefix))
+	}
+	if strings.HasPrefix(value, markdownReporterPrefix) {
+		maxSize, err := strconv.Atoi(strings.TrimPrefix(value, markdownReporterPrefix))
+		if err != nil || maxSize < 0 {
+			return nil, fmt.Errorf("invalid max size of the markdown reporter in %s", value)
+		}
+		return reporters.NewMarkdownReporter(maxSize), nil
+	}
+
 	rs := map[string]report.Reporter{
-		"plain":   reporters.PlainReporter{},
-		"junit":   reporters.JUnitReporter{},
-		"unix":    reporters.UnixReporter{},
-		"json":    reporters.JSONReporter{},
-		"sarif":   reporters.SarifReporter{},
-		"sonar":   reporters.SonarReporter{},
-		"tsc":     reporters.TscReporter{},
-		"mcp":     reporters.MCPReporter{},
-		"ci":      reporters.NewCiReporterWithGenericFormat(),
-		"ci-az":   reporters.NewCiReporterForAzureDevOps(),
-		"ci-gh":   reporters.NewCiReporterForGithubActions(),
-		"ci-glab": reporters.NewCiReporterForGitlab(),
-		"ci-env":  reporters.NewCiReporterFromEnv(),
+		"plain":      reporters.PlainReporter{},
+		"junit":      reporters.JUnitReporter{},
+		"unix":       reporters.UnixReporter{},
+		"json":       reporters.JSONReporter{},
+		"sarif":      reporters.SarifReporter{},
+		"sonar":      reporters.SonarReporter{},
+		"tsc":        reporters.TscReporter{},
+		"mcp":        reporters.MCPReporter{},
+		"checkstyle": reporters.CheckstyleReporter{},
+		"gitlab":     reporters.GitlabReporter{},
+		"html":       reporters.HTMLReporter{},
+		"pretty":     reporters.PrettyReporter{},
+		"summary":    reporters.SummaryReporter{},
+		"markdown":   reporters.NewMarkdownReporter(reporters.DefaultMarkdownMaxSize),
+		"rdjson":     reporters.RdjsonReporter{},
+		"rdjsonl":    reporters.RdjsonlReporter{},
+		"ci":         reporters.NewCiReporterWithGenericFormat(),
+		"ci-az":      reporters.NewCiReporterForAzureDevOps(),
+		"ci-gh":      reporters.NewCiReporterForGithubActions(),
+		"ci-glab":    reporters.NewCiReporterForGitlab(),
+		"ci-env":     reporters.NewCiReporterFromEnv(),
 	}
 	if r, ok := rs[value]; ok {
 		return r, nil
 	}
-	return nil, fmt.Errorf(`available reporters are "plain", "junit", "json", "sarif", "unix", "mcp", available reporters for CI/CD are ci, ci-az, ci-gh, ci-glab"`)
+	return nil, fmt.Errorf(`available reporters are "plain", "junit", "json", "sarif", "sonar", "tsc", "unix", "mcp", "checkstyle", "gitlab", "html", "pretty", "summary", "markdown", "rdjson", "rdjsonl", "template=path/to/file.tmpl", available reporters for CI/CD are ci, ci-az, ci-gh, ci-glab"`)
 }
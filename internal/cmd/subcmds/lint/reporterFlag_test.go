@@ -0,0 +1,60 @@
+package lint_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/lint"
+)
+
+func TestAddReporter_templatedPath(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "foo.proto")
+	if err := os.WriteFile(path, []byte(runInfoProto), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	flags, err := lint.NewFlags([]string{
+		"-add-reporter", "json:" + filepath.Join(dir, "reports", "{reporter}.{timestamp}.json"),
+		"-add-reporter", "unix:" + filepath.Join(dir, "reports", "{reporter}.{timestamp}.txt"),
+		path,
+	})
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	cmd, err := lint.NewCmdLint(flags, &bytes.Buffer{}, &bytes.Buffer{})
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	_ = cmd.Run()
+
+	entries, err := os.ReadDir(filepath.Join(dir, "reports"))
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	var names []string
+	for _, e := range entries {
+		names = append(names, e.Name())
+	}
+	if len(names) != 2 {
+		t.Errorf("got %v, but want the json and unix reports", names)
+		return
+	}
+
+	json := regexp.MustCompile(`^json\.(\d{8}T\d{6}Z)\.json$`).FindStringSubmatch(names[0])
+	unix := regexp.MustCompile(`^unix\.(\d{8}T\d{6}Z)\.txt$`).FindStringSubmatch(names[1])
+	if json == nil || unix == nil {
+		t.Errorf("got %v, but want the expanded names", names)
+		return
+	}
+	if json[1] != unix[1] {
+		t.Errorf("got the timestamps %s and %s, but want the same one", json[1], unix[1])
+	}
+}
@@ -0,0 +1,198 @@
+package lint
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	protoparser "github.com/yoheimuta/go-protoparser/v4"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/addon/rules"
+	"github.com/maramkhaledn/protolint/internal/linter"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/internal/stringsutil"
+	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/disablerule"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// neutralizedDisable replaces disablerule.PrefixDisable to find the suppressed failures.
+// It keeps the length so that the positions don't change.
+const neutralizedDisable = "protolint:DISABLE"
+
+// runInfo collects the information of the run for the reporters.
+//
+// The fixes are recorded during the run, and the suppressed failures are found by linting the files again
+// with only the disabled rules. They are collected only if full is true,
+// and not with -fix or -auto_disable because the files have already been modified.
+func (c *CmdLint) runInfo(
+	failures []report.Failure,
+	exitCode osutil.ExitCode,
+	full bool,
+) (internalreport.RunInfo, error) {
+	info := internalreport.RunInfo{
+		ConfigPath: c.config.external.SourcePath,
+		ExitCode:   exitCode,
+		Stats: internalreport.Stats{
+			RuleElapsed: c.l.Elapsed(),
+		},
+	}
+	if full && c.fixRecorder != nil {
+		info.Fixes = c.fixRecorder.Fixes()
+	}
+	shadowed := full && !c.config.fixMode && c.config.autoDisableType == autodisable.Noop
+
+	descriptors := make(map[string]internalreport.RuleDescriptor)
+	for i, f := range c.protoFiles {
+		rs := c.fileRules[i]
+		for _, r := range rs {
+			if r, ok := r.(rule.Rule); ok {
+				if _, found := descriptors[r.ID()]; !found {
+					descriptors[r.ID()] = newRuleDescriptor(r)
+				}
+			}
+		}
+		if len(rs) == 0 {
+			info.Stats.FilesSkipped++
+			continue
+		}
+		info.Stats.FilesLinted++
+		if !shadowed {
+			continue
+		}
+
+		suppressed, err := c.suppressedFailures(f, failures)
+		if err != nil {
+			return internalreport.RunInfo{}, err
+		}
+		info.Suppressed = append(info.Suppressed, suppressed...)
+	}
+
+	info.Stats.FixesApplied = c.fixCounter.Count()
+
+	for _, d := range descriptors {
+		info.Rules = append(info.Rules, d)
+	}
+	sort.Slice(info.Rules, func(i, j int) bool {
+		return info.Rules[i].ID < info.Rules[j].ID
+	})
+	return info, nil
+}
+
+// suppressedFailures returns the failures of the file disabled by the protolint:disable comments.
+// It lints the file in memory with the comments neutralized, applying only the rules they disable.
+// The rules are generated again so that they read the neutralized content and record no fixes.
+func (c *CmdLint) suppressedFailures(
+	f file.ProtoFile,
+	failures []report.Failure,
+) ([]report.Failure, error) {
+	content, err := os.ReadFile(f.Path())
+	if err != nil {
+		return nil, err
+	}
+	disabled := disabledRuleIDs(content)
+	if len(disabled) == 0 {
+		return nil, nil
+	}
+
+	neutralized := bytes.ReplaceAll(content, []byte(disablerule.PrefixDisable), []byte(neutralizedDisable))
+	files := osutil.NewFiles()
+	files.Add(f.DisplayPath(), neutralized)
+	rs, err := c.config.GenRules(f, visitor.Env{Files: files})
+	if err != nil {
+		return nil, err
+	}
+
+	var targets []rule.HasApply
+	for _, r := range rs {
+		if id, ok := r.(rule.HasID); ok && stringsutil.ContainsStringInSlice(id.ID(), disabled) {
+			targets = append(targets, r)
+		}
+	}
+	if len(targets) == 0 {
+		return nil, nil
+	}
+
+	proto, err := protoparser.Parse(
+		bytes.NewReader(neutralized),
+		protoparser.WithFilename(f.DisplayPath()),
+		protoparser.WithBodyIncludingComments(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Use another linter not to count the elapsed time.
+	all, err := linter.NewLinter().RunWithImporter(func(*parser.Proto) (*parser.Proto, error) {
+		return proto, nil
+	}, c.importer, targets)
+	if err != nil {
+		return nil, err
+	}
+	return subtractFailures(all, failures), nil
+}
+
+// disabledRuleIDs returns the IDs of the rules which the protolint:disable comments in the content disable.
+func disabledRuleIDs(content []byte) []string {
+	if !bytes.Contains(content, []byte(disablerule.PrefixDisable)) {
+		return nil
+	}
+
+	var ids []string
+	for _, re := range []*regexp.Regexp{
+		disablerule.ReDisable,
+		disablerule.ReDisableNext,
+		disablerule.ReDisableThis,
+	} {
+		for _, subs := range re.FindAllSubmatch(content, -1) {
+			for _, id := range strings.Fields(string(subs[1])) {
+				if !stringsutil.ContainsStringInSlice(id, ids) {
+					ids = append(ids, id)
+				}
+			}
+		}
+	}
+	return ids
+}
+
+func newRuleDescriptor(r rule.Rule) internalreport.RuleDescriptor {
+	inner := r
+	if unwrapper, ok := r.(interface{ Unwrap() rule.Rule }); ok {
+		inner = unwrapper.Unwrap()
+	}
+	fixable, ok := r.(rule.HasIsFixable)
+	return internalreport.RuleDescriptor{
+		ID:       r.ID(),
+		Purpose:  r.Purpose(),
+		Severity: r.Severity(),
+		HelpURI:  rules.HelpURI(inner),
+		Fixable:  ok && fixable.IsFixable(),
+	}
+}
+
+// subtractFailures returns the failures in fs which are not in others.
+func subtractFailures(
+	fs []report.Failure,
+	others []report.Failure,
+) []report.Failure {
+	counts := make(map[report.Failure]int)
+	for _, o := range others {
+		counts[o]++
+	}
+	var rest []report.Failure
+	for _, f := range fs {
+		if 0 < counts[f] {
+			counts[f]--
+			continue
+		}
+		rest = append(rest, f)
+	}
+	return rest
+}
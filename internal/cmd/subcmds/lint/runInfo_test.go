@@ -0,0 +1,211 @@
+package lint_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/lint"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+)
+
+const runInfoProto = `syntax = "proto3";
+package foo;
+import "b.proto";
+import "a.proto";
+
+message FooBar {
+  string FieldA = 1; // protolint:disable:this FIELD_NAMES_LOWER_SNAKE_CASE
+  string FieldB = 2;
+  string FieldC = 3;
+}
+`
+
+type sarifDocument struct {
+	Runs []struct {
+		Invocations []struct {
+			ExecutionSuccessful bool `json:"executionSuccessful"`
+			ExitCode            int  `json:"exitCode"`
+		} `json:"invocations"`
+		Results []struct {
+			RuleID  string `json:"ruleId"`
+			Message struct {
+				Text string `json:"text"`
+			} `json:"message"`
+			Fixes []struct {
+				ArtifactChanges []struct {
+					Replacements []struct {
+						InsertedContent struct {
+							Text string `json:"text"`
+						} `json:"insertedContent"`
+					} `json:"replacements"`
+				} `json:"artifactChanges"`
+			} `json:"fixes"`
+			Suppressions []struct {
+				Kind string `json:"kind"`
+			} `json:"suppressions"`
+		} `json:"results"`
+		Tool struct {
+			Driver struct {
+				Rules []struct {
+					ID               string `json:"id"`
+					HelpURI          string `json:"helpUri"`
+					ShortDescription struct {
+						Text string `json:"text"`
+					} `json:"shortDescription"`
+				} `json:"rules"`
+			} `json:"driver"`
+		} `json:"tool"`
+	} `json:"runs"`
+}
+
+func TestCmdLint_Run_SarifWithRunInfo(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "foo.proto")
+	if err := os.WriteFile(path, []byte(runInfoProto), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	flags, err := lint.NewFlags([]string{"-reporter", "sarif", path})
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+	cmd, err := lint.NewCmdLint(flags, stdout, stderr)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if got := cmd.Run(); got != osutil.ExitLintFailure {
+		t.Errorf("got exit code %v, but want %v: %s", got, osutil.ExitLintFailure, stderr)
+		return
+	}
+
+	var doc sarifDocument
+	if err := json.Unmarshal(stderr.Bytes(), &doc); err != nil {
+		t.Errorf("got err %v: %s", err, stderr)
+		return
+	}
+	run := doc.Runs[0]
+
+	if len(run.Invocations) != 1 || !run.Invocations[0].ExecutionSuccessful || run.Invocations[0].ExitCode != 1 {
+		t.Errorf("got invocations %v, but want the successful one with the exit code 1", run.Invocations)
+	}
+
+	found := make(map[string]bool)
+	for _, r := range run.Tool.Driver.Rules {
+		if r.ID == "IMPORTS_SORTED" {
+			found[r.ID] = r.ShortDescription.Text == "Enforces sorted imports." &&
+				r.HelpURI == "https://github.com/maramkhaledn/protolint/blob/master/internal/addon/rules/importsSortedRule.go"
+		}
+	}
+	if !found["IMPORTS_SORTED"] {
+		t.Errorf("got rules %v, but want the descriptor of IMPORTS_SORTED", run.Tool.Driver.Rules)
+	}
+
+	var importFixes, fieldFixes, suppressed int
+	for _, r := range run.Results {
+		switch {
+		case 0 < len(r.Suppressions):
+			if r.RuleID != "FIELD_NAMES_LOWER_SNAKE_CASE" || r.Suppressions[0].Kind != "inSource" {
+				t.Errorf("got the suppressed result %v, but want only FieldA", r)
+			}
+			suppressed++
+		case r.RuleID == "IMPORTS_SORTED":
+			// Both imports must be fixed together.
+			if len(r.Fixes) == 1 && len(r.Fixes[0].ArtifactChanges[0].Replacements) == 2 {
+				importFixes++
+			}
+		case r.RuleID == "FIELD_NAMES_LOWER_SNAKE_CASE":
+			// Each field has only its own fix.
+			want := "  string field_b = 2;"
+			if strings.Contains(r.Message.Text, `"FieldC"`) {
+				want = "  string field_c = 3;"
+			}
+			replacements := r.Fixes[0].ArtifactChanges[0].Replacements
+			if len(r.Fixes) == 1 && len(replacements) == 1 && replacements[0].InsertedContent.Text == want {
+				fieldFixes++
+			}
+		}
+	}
+	if importFixes != 2 || fieldFixes != 2 || suppressed != 1 {
+		t.Errorf("got %d import fixes, %d field fixes and %d suppressed, but want 2, 2 and 1", importFixes, fieldFixes, suppressed)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if string(content) != runInfoProto {
+		t.Errorf("got the modified file %s", content)
+	}
+}
+
+func TestCmdLint_Run_Stats(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputArgs    []string
+		wantContains []string
+	}{
+		{
+			name:      "Prints the statistics after the failures",
+			inputArgs: []string{"-stats"},
+			wantContains: []string{
+				"Failures: 4\n",
+				"  IMPORTS_SORTED                2\n",
+				"Files: 1 linted, 0 skipped\n",
+				"Fixes applied: 0\n",
+				"Time by rule:\n",
+			},
+		},
+		{
+			name:      "Counts the replacements applied by the fixes",
+			inputArgs: []string{"-stats", "-fix"},
+			wantContains: []string{
+				// Moving the import takes 2 replacements, and renaming the adjacent fields takes 1.
+				"Fixes applied: 3\n",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "foo.proto")
+			if err := os.WriteFile(path, []byte(runInfoProto), 0644); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			flags, err := lint.NewFlags(append(test.inputArgs, path))
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			stdout := &bytes.Buffer{}
+			stderr := &bytes.Buffer{}
+			cmd, err := lint.NewCmdLint(flags, stdout, stderr)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			_ = cmd.Run()
+
+			got := stderr.String()
+			for _, want := range test.wantContains {
+				if !strings.Contains(got, want) {
+					t.Errorf("got %s, but want to contain %s", got, want)
+				}
+			}
+			if !strings.Contains(got, "  FIELD_NAMES_LOWER_SNAKE_CASE  ") {
+				t.Errorf("got %s, but want the time of FIELD_NAMES_LOWER_SNAKE_CASE", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+)
+
+type settingFlags struct {
+	raws     []string
+	settings []config.Setting
+}
+
+func (fs *settingFlags) String() string {
+	return strings.Join(fs.raws, " ")
+}
+
+func (fs *settingFlags) Set(value string) error {
+	s, err := config.ParseSetting(value)
+	if err != nil {
+		return err
+	}
+
+	fs.raws = append(fs.raws, value)
+	fs.settings = append(fs.settings, s)
+	return nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/osutil"
 	"github.com/maramkhaledn/protolint/linter/autodisable"
 	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 // CmdList is a rule list command.
@@ -69,7 +70,7 @@ type hasIDAndPurpose interface {
 }
 
 func hasIDAndPurposes(plugins []shared.RuleSet) ([]hasIDAndPurpose, error) {
-	rs, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, false, plugins)
+	rs, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, visitor.Env{}, false, plugins)
 	if err != nil {
 		return nil, err
 	}
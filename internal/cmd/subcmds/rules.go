@@ -7,6 +7,7 @@ import (
 	"github.com/maramkhaledn/protolint/internal/linter/config"
 	internalrule "github.com/maramkhaledn/protolint/internal/linter/rule"
 	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 	// Add your custom rules import
 	// customrules "github.com/maramkhaledn/protolint/plugin/customrules"
 )
@@ -16,10 +17,11 @@ func NewAllRules(
 	option config.RulesOption,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 	verbose bool,
 	plugins []shared.RuleSet,
 ) (internalrule.Rules, error) {
-	rs := newAllInternalRules(option, fixMode, autoDisableType)
+	rs := newAllInternalRules(option, fixMode, autoDisableType, env)
 
 	es, err := plugin.GetExternalRules(plugins, fixMode, verbose)
 	if err != nil {
@@ -33,6 +35,7 @@ func newAllInternalRules(
 	option config.RulesOption,
 	fixMode bool,
 	autoDisableType autodisable.PlacementType,
+	env visitor.Env,
 ) internalrule.Rules {
 	syntaxConsistent := option.SyntaxConsistent
 	fileNamesLowerSnakeCase := option.FileNamesLowerSnakeCase
@@ -62,85 +65,103 @@ func newAllInternalRules(
 			fileNamesLowerSnakeCase.Severity,
 			fileNamesLowerSnakeCase.Excludes,
 			fixMode,
+			env,
 		),
 		rules.NewQuoteConsistentRule(
 			option.QuoteConsistentOption.Severity,
 			option.QuoteConsistentOption.Quote,
 			fixMode,
+			env,
 		),
 		rules.NewOrderRule(
 			option.Order.Severity,
 			fixMode,
+			env,
 		),
 		rules.NewIndentRule(
 			indent.Severity,
 			indent.Style,
 			indent.NotInsertNewline,
 			fixMode,
+			env,
 		),
 		rules.NewMaxLineLengthRule(
 			maxLineLength.Severity,
 			maxLineLength.MaxChars,
 			maxLineLength.TabChars,
+			env,
 		),
 		rules.NewPackageNameLowerCaseRule(
 			option.PackageNameLowerCase.Severity,
 			fixMode,
+			env,
 		),
 		rules.NewImportsSortedRule(
 			option.ImportsSorted.Severity,
 			fixMode,
+			env,
 		),
 		rules.NewEnumFieldNamesPrefixRule(
 			option.EnumFieldNamesPrefix.Severity,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewEnumFieldNamesUpperSnakeCaseRule(
 			option.EnumFieldNamesUpperSnakeCase.Severity,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewEnumFieldNamesZeroValueEndWithRule(
 			enumFieldNamesZeroValueEndWith.Severity,
 			enumFieldNamesZeroValueEndWith.Suffix,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewEnumFieldsHaveCommentRule(
 			enumFieldsHaveComment.Severity,
 			enumFieldsHaveComment.ShouldFollowGolangStyle,
+			env,
 		),
 		rules.NewEnumNamesUpperCamelCaseRule(
 			option.EnumFieldNamesUpperSnakeCase.Severity,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewEnumsHaveCommentRule(
 			enumsHaveComment.Severity,
 			enumsHaveComment.ShouldFollowGolangStyle,
+			env,
 		),
 		rules.NewFieldNamesLowerSnakeCaseRule(
 			option.FieldNamesLowerSnakeCase.Severity,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewFieldNamesExcludePrepositionsRule(
 			fieldNamesExcludePrepositions.Severity,
 			fieldNamesExcludePrepositions.Prepositions,
 			fieldNamesExcludePrepositions.Excludes,
+			env,
 		),
 		rules.NewFieldsHaveCommentRule(
 			fieldsHaveComment.Severity,
 			fieldsHaveComment.ShouldFollowGolangStyle,
+			env,
 		),
 		rules.NewProto3FieldsAvoidRequiredRule(
 			option.Proto3FieldsAvoidRequired.Severity,
 			fixMode,
+			env,
 		),
 		rules.NewProto3GroupsAvoidRule(
 			option.Proto3GroupsAvoid.Severity,
 			autoDisableType,
+			env,
 		),
 		rules.NewRepeatedFieldNamesPluralizedRule(
 			repeatedFieldNamesPluralized.Severity,
@@ -150,49 +171,76 @@ func newAllInternalRules(
 			repeatedFieldNamesPluralized.IrregularRules,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewMessageNamesUpperCamelCaseRule(
 			option.MessageNamesUpperCamelCase.Severity,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewMessageNamesExcludePrepositionsRule(
 			messageNamesExcludePrepositions.Severity,
 			messageNamesExcludePrepositions.Prepositions,
 			messageNamesExcludePrepositions.Excludes,
+			env,
 		),
 		rules.NewMessagesHaveCommentRule(
 			messagesHaveComment.Severity,
 			messagesHaveComment.ShouldFollowGolangStyle,
+			env,
 		),
 		rules.NewRPCNamesUpperCamelCaseRule(
 			option.RPCNamesUpperCamelCase.Severity,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewRPCNamesCaseRule(
 			option.RPCNamesCaseOption.Severity,
 			option.RPCNamesCaseOption.Convention,
+			env,
 		),
 		rules.NewRPCsHaveCommentRule(
 			rpcsHaveComment.Severity,
 			rpcsHaveComment.ShouldFollowGolangStyle,
+			env,
 		),
 		rules.NewRPCVersioningRule(
 			option.RPCVersioning.Severity,
 		),
+		rules.NewReferencesResolvedRule(
+			option.ReferencesResolved.Severity,
+			env,
+		),
+		rules.NewImportsUsedRule(
+			option.ImportsUsed.Severity,
+			fixMode,
+			env,
+		),
+		rules.NewImportsAcyclicRule(
+			option.ImportsAcyclic.Severity,
+		),
+		rules.NewPackageDirectoryMatchRule(
+			option.PackageDirectoryMatch.Severity,
+			option.PackageDirectoryMatch.Root,
+			env,
+		),
 		rules.NewServiceNamesUpperCamelCaseRule(
 			option.ServiceNamesUpperCamelCase.Severity,
 			fixMode,
 			autoDisableType,
+			env,
 		),
 		rules.NewServiceNamesEndWithRule(
 			option.ServiceNamesEndWith.Severity,
 			serviceNamesEndWith.Text,
+			env,
 		),
 		rules.NewServicesHaveCommentRule(
 			option.ServicesHaveComment.Severity,
 			servicesHaveComment.ShouldFollowGolangStyle,
+			env,
 		),
 
 	}
@@ -0,0 +1,61 @@
+package filepathutil
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// RegexpPatternPrefix is the prefix to write a regular expression instead of a glob pattern.
+const RegexpPatternPrefix = "regex:"
+
+// IsUnixPathPattern checks whether an unix path is a pattern rather than a literal path.
+//
+// A pattern is either a regular expression prefixed with RegexpPatternPrefix or
+// a doublestar glob pattern, like "**/third_party/**" and "*_internal.proto".
+func IsUnixPathPattern(unixPath string) bool {
+	if strings.HasPrefix(unixPath, RegexpPatternPrefix) {
+		return true
+	}
+	return strings.ContainsAny(unixPath, "*?[{")
+}
+
+// ValidateUnixPathPattern checks whether an unix path pattern is well-formed.
+func ValidateUnixPathPattern(pattern string) error {
+	if expr, ok := strings.CutPrefix(pattern, RegexpPatternPrefix); ok {
+		_, err := regexp.Compile(expr)
+		return err
+	}
+	if !doublestar.ValidatePattern(pattern) {
+		return doublestar.ErrBadPattern
+	}
+	return nil
+}
+
+// MatchUnixPathPattern checks whether a cross platform path matches an unix path pattern.
+//
+// A glob pattern must match the whole path, whereas a regular expression matches
+// any part of the path unless it is anchored. A malformed pattern matches nothing.
+func MatchUnixPathPattern(pattern, crossPlatformPath string) bool {
+	unixPath := ToUnixPath(crossPlatformPath)
+	if expr, ok := strings.CutPrefix(pattern, RegexpPatternPrefix); ok {
+		matched, err := regexp.MatchString(expr, unixPath)
+		return err == nil && matched
+	}
+	matched, err := doublestar.Match(pattern, unixPath)
+	return err == nil && matched
+}
+
+// ToUnixPath converts a cross platform path to an unix path.
+func ToUnixPath(crossPlatformPath string) string {
+	if OSPathSeparator == unixPathSeparator {
+		return crossPlatformPath
+	}
+	return strings.Replace(
+		crossPlatformPath,
+		osPathSeparator(),
+		string(unixPathSeparator),
+		-1,
+	)
+}
@@ -1,6 +1,7 @@
 package libinternal
 
 import (
+	"context"
 	"errors"
 	"io"
 
@@ -10,6 +11,8 @@ import (
 var (
 	// ErrLintFailure error is returned when there is a linting error
 	ErrLintFailure = errors.New("lint error")
+	// ErrLintWarningFailure error is returned when there are only linting warnings above the threshold.
+	ErrLintWarningFailure = errors.New("lint warning")
 	// ErrInternalFailure error is returned when there is a parsing, internal, or runtime error.
 	ErrInternalFailure = errors.New("parsing, internal or runtime errors")
 )
@@ -19,6 +22,12 @@ type LintRunner interface {
 	Run(args []string, stdout, stderr io.Writer) osutil.ExitCode
 }
 
+// ContextLintRunner is a LintRunner which can stop linting when the context is done
+type ContextLintRunner interface {
+	LintRunner
+	RunContext(ctx context.Context, args []string, stdout, stderr io.Writer) osutil.ExitCode
+}
+
 var defaultRunner LintRunner
 
 // SetLintRunner sets the runner used by the Lint function
@@ -34,7 +43,8 @@ func GetLintRunner() LintRunner {
 // Lint is used to lint Protocol Buffer files with the protolint tool.
 // It takes an array of strings (args) representing command line arguments,
 // as well as two io.Writer instances (stdout and stderr) to which the output of the command should be written.
-// It returns an error in the case of a linting error (ErrLintFailure)
+// It returns an error in the case of a linting error (ErrLintFailure),
+// only linting warnings above the threshold (ErrLintWarningFailure)
 // or a parsing, internal, or runtime error (ErrInternalFailure).
 // Otherwise, it returns nil on success.
 func Lint(args []string, stdout, stderr io.Writer) error {
@@ -42,13 +52,55 @@ func Lint(args []string, stdout, stderr io.Writer) error {
 		return ErrInternalFailure
 	}
 
-	switch defaultRunner.Run(args, stdout, stderr) {
+	return lintError(defaultRunner.Run(args, stdout, stderr))
+}
+
+// LintContext lints like Lint, and stops linting when ctx is done if the runner is a ContextLintRunner.
+// It returns the cause of ctx if ctx is done.
+func LintContext(ctx context.Context, args []string, stdout, stderr io.Writer) error {
+	if defaultRunner == nil {
+		return ErrInternalFailure
+	}
+	if err := context.Cause(ctx); err != nil {
+		return err
+	}
+
+	runner, ok := defaultRunner.(ContextLintRunner)
+	if !ok {
+		return lintError(defaultRunner.Run(args, stdout, stderr))
+	}
+	exitCode := runner.RunContext(ctx, args, stdout, stderr)
+	if err := context.Cause(ctx); err != nil {
+		return err
+	}
+	return lintError(exitCode)
+}
+
+type fileLintedKey struct{}
+
+// WithFileLinted returns a copy of ctx which makes LintContext call fileLinted after linting each file
+// with the path of the file, the number of the files linted so far and the number of all the files.
+func WithFileLinted(ctx context.Context, fileLinted func(path string, linted, total int)) context.Context {
+	return context.WithValue(ctx, fileLintedKey{}, fileLinted)
+}
+
+// FileLinted returns the function given to WithFileLinted, or nil if there isn't.
+func FileLinted(ctx context.Context) func(path string, linted, total int) {
+	fileLinted, _ := ctx.Value(fileLintedKey{}).(func(path string, linted, total int))
+	return fileLinted
+}
+
+func lintError(exitCode osutil.ExitCode) error {
+	switch exitCode {
 	case osutil.ExitSuccess:
 		return nil
 
 	case osutil.ExitLintFailure:
 		return ErrLintFailure
 
+	case osutil.ExitLintWarningFailure:
+		return ErrLintWarningFailure
+
 	default:
 		return ErrInternalFailure
 	}
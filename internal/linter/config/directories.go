@@ -1,11 +1,5 @@
 package config
 
-import (
-	"strings"
-
-	"github.com/maramkhaledn/protolint/internal/filepathutil"
-)
-
 // Directories represents the target directories.
 type Directories struct {
 	Exclude []string `yaml:"exclude" json:"exclude" toml:"exclude"`
@@ -14,13 +8,5 @@ type Directories struct {
 func (d Directories) shouldSkipRule(
 	displayPath string,
 ) bool {
-	for _, exclude := range d.Exclude {
-		if !strings.HasSuffix(exclude, string(filepathutil.OSPathSeparator)) {
-			exclude += string(filepathutil.OSPathSeparator)
-		}
-		if filepathutil.HasUnixPathPrefix(displayPath, exclude) {
-			return true
-		}
-	}
-	return false
+	return pathPatterns(d.Exclude).matchDirectory(displayPath)
 }
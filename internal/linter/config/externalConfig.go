@@ -1,5 +1,11 @@
 package config
 
+import (
+	"fmt"
+
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
 // Lint represents the lint configuration.
 type Lint struct {
 	Ignores     Ignores
@@ -7,6 +13,9 @@ type Lint struct {
 	Directories Directories
 	Rules       Rules
 	RulesOption RulesOption `yaml:"rules_option" json:"rules_option" toml:"rules_option"`
+	Overrides   Overrides
+	// MaxWarnings is the number of warnings tolerated before the lint fails.
+	MaxWarnings *int `yaml:"max_warnings" json:"max_warnings" toml:"max_warnings"`
 }
 
 // ExternalConfig represents the external configuration.
@@ -27,3 +36,55 @@ func (c ExternalConfig) ShouldSkipRule(
 		lint.Directories.shouldSkipRule(displayPath) ||
 		lint.Rules.shouldSkipRule(ruleID, defaultRuleIDs)
 }
+
+// RulesOptionFor returns the rules option applied to the file, taking the overrides into account.
+func (c ExternalConfig) RulesOptionFor(
+	displayPath string,
+) (RulesOption, error) {
+	return c.Lint.Overrides.rulesOption(c.Lint.RulesOption, displayPath)
+}
+
+// SeverityFor returns the severity of the rule overridden for the file, if any.
+func (c ExternalConfig) SeverityFor(
+	ruleID string,
+	displayPath string,
+) (rule.Severity, bool) {
+	return c.Lint.Overrides.severity(ruleID, displayPath)
+}
+
+// ValidateOverrideRuleIDs checks whether the overrides name only the known rules,
+// which include the ones of the plugins.
+func (c ExternalConfig) ValidateOverrideRuleIDs(
+	ruleIDs []string,
+) error {
+	if err := c.Lint.Overrides.validateRuleIDs(ruleIDs); err != nil {
+		return fmt.Errorf("%s: invalid overrides: %v", c.SourcePath, err)
+	}
+	return nil
+}
+
+// validate checks whether max_warnings, the path patterns and the overrides are well-formed.
+func (c ExternalConfig) validate() error {
+	lint := c.Lint
+	if lint.MaxWarnings != nil && *lint.MaxWarnings < 0 {
+		return fmt.Errorf("%s: max_warnings must not be negative, but got %d", c.SourcePath, *lint.MaxWarnings)
+	}
+	patterns := []pathPatterns{
+		lint.Files.Exclude,
+		lint.Directories.Exclude,
+	}
+	for _, ignore := range lint.Ignores {
+		patterns = append(patterns, ignore.Files)
+	}
+	for _, ps := range patterns {
+		if err := ps.validate(); err != nil {
+			return fmt.Errorf("%s: %v", c.SourcePath, err)
+		}
+	}
+	for _, o := range lint.Overrides {
+		if err := o.validate(); err != nil {
+			return fmt.Errorf("%s: invalid overrides: %v", c.SourcePath, err)
+		}
+	}
+	return nil
+}
@@ -10,7 +10,7 @@ import (
 )
 
 type configLoader interface {
-	LoadExternalConfig() (*ExternalConfig, error)
+	LoadExternalConfig(settings []Setting) (*ExternalConfig, error)
 }
 
 func loadFileContent(file string) ([]byte, error) {
@@ -30,19 +30,45 @@ func GetExternalConfig(
 	filePath string,
 	dirPath string,
 ) (*ExternalConfig, error) {
+	return GetExternalConfigWithSettings(filePath, dirPath, nil)
+}
+
+// GetExternalConfigWithSettings provides the externalConfig with the settings layered on top.
+//
+// The precedence order, from lowest to highest, is the defaults, the config file,
+// and the settings in order. The config file is one of .protolint.yaml, package.json
+// and pyproject.toml, and the settings apply to any of them in the same way.
+// If no config file is found, the settings apply to the empty config.
+func GetExternalConfigWithSettings(
+	filePath string,
+	dirPath string,
+	settings []Setting,
+) (*ExternalConfig, error) {
+	var config *ExternalConfig
 	reader, err := getExternalConfigLoader(filePath, dirPath)
-	if err != nil {
-		if len(filePath) == 0 && len(dirPath) == 0 {
-			return nil, nil
+	switch {
+	case err == nil:
+		config, err = reader.LoadExternalConfig(settings)
+		if err != nil {
+			return nil, err
 		}
+	case 0 < len(filePath) || 0 < len(dirPath):
 		return nil, err
 	}
 
-	if err != nil {
+	if config == nil {
+		if len(settings) == 0 {
+			return nil, nil
+		}
+		config, err = decodeYAMLConfig(nil, settings)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if err := config.validate(); err != nil {
 		return nil, err
 	}
-
-	return reader.LoadExternalConfig()
+	return config, nil
 }
 
 func getLoaderFromExtension(filePath string) (configLoader, error) {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/maramkhaledn/protolint/internal/filepathutil"
 	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 
 	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
 	"github.com/maramkhaledn/protolint/internal/linter/config"
@@ -98,7 +99,33 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 		},
 	}
 
-	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, false, nil)
+	patternExternalConfig := config.ExternalConfig{
+		Lint: config.Lint{
+			Ignores: []config.Ignore{
+				{
+					ID: "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+					Files: []string{
+						"*_internal.proto",
+						"regex:^legacy/.*_v1\\.proto$",
+					},
+				},
+			},
+			Directories: config.Directories{
+				Exclude: []string{
+					"**/third_party",
+					"!**/third_party/ours",
+				},
+			},
+			Files: config.Files{
+				Exclude: []string{
+					"**/*_generated.proto",
+					"!path/to/keep_generated.proto",
+				},
+			},
+		},
+	}
+
+	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, visitor.Env{}, false, nil)
 	if err != nil {
 		t.Error(err)
 		return
@@ -307,6 +334,74 @@ func TestExternalConfig_ShouldSkipRule(t *testing.T) {
 			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
 			inputDisplayPath: `path/to/file_windows.proto`,
 		},
+		{
+			name:             "ignore the file matched by a glob pattern",
+			externalConfig:   patternExternalConfig,
+			inputRuleID:      "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			inputDisplayPath: "foo_internal.proto",
+			inputDefaultRuleIDs: []string{
+				"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			},
+			wantSkipRule: true,
+		},
+		{
+			name:             "not ignore the file in a directory because * doesn't match a separator",
+			externalConfig:   patternExternalConfig,
+			inputRuleID:      "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			inputDisplayPath: "path/to/foo_internal.proto",
+			inputDefaultRuleIDs: []string{
+				"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			},
+		},
+		{
+			name:             "ignore the file matched by a regular expression",
+			externalConfig:   patternExternalConfig,
+			inputRuleID:      "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			inputDisplayPath: "legacy/path/to/foo_v1.proto",
+			inputDefaultRuleIDs: []string{
+				"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			},
+			wantSkipRule: true,
+		},
+		{
+			name:                        "exclude the windows directory matched by a glob pattern",
+			externalConfig:              patternExternalConfig,
+			inputRuleID:                 "FIELD_NAMES_LOWER_SNAKE_CASE",
+			inputDisplayPath:            `path\to\third_party\google\foo.proto`,
+			inputIsWindowsPathSeparator: true,
+			inputDefaultRuleIDs: []string{
+				"FIELD_NAMES_LOWER_SNAKE_CASE",
+			},
+			wantSkipRule: true,
+		},
+		{
+			name:             "not exclude the directory matched by a negation pattern",
+			externalConfig:   patternExternalConfig,
+			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
+			inputDisplayPath: "path/to/third_party/ours/foo.proto",
+			inputDefaultRuleIDs: []string{
+				"FIELD_NAMES_LOWER_SNAKE_CASE",
+			},
+		},
+		{
+			name:             "exclude the file matched by a glob pattern",
+			externalConfig:   patternExternalConfig,
+			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
+			inputDisplayPath: "path/to/foo_generated.proto",
+			inputDefaultRuleIDs: []string{
+				"FIELD_NAMES_LOWER_SNAKE_CASE",
+			},
+			wantSkipRule: true,
+		},
+		{
+			name:             "not exclude the file matched by a negation pattern",
+			externalConfig:   patternExternalConfig,
+			inputRuleID:      "FIELD_NAMES_LOWER_SNAKE_CASE",
+			inputDisplayPath: "path/to/keep_generated.proto",
+			inputDefaultRuleIDs: []string{
+				"FIELD_NAMES_LOWER_SNAKE_CASE",
+			},
+		},
 	} {
 		test := test
 		t.Run(test.name, func(t *testing.T) {
@@ -1,7 +1,5 @@
 package config
 
-import "github.com/maramkhaledn/protolint/internal/stringsutil"
-
 // Files represents the target files.
 type Files struct {
 	Exclude []string `yaml:"exclude" json:"exclude" toml:"exclude"`
@@ -10,5 +8,5 @@ type Files struct {
 func (d Files) shouldSkipRule(
 	displayPath string,
 ) bool {
-	return stringsutil.ContainsCrossPlatformPathInSlice(displayPath, d.Exclude)
+	return pathPatterns(d.Exclude).matchFile(displayPath)
 }
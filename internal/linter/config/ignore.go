@@ -1,7 +1,5 @@
 package config
 
-import "github.com/maramkhaledn/protolint/internal/stringsutil"
-
 // Ignore represents files ignoring the specific rule.
 type Ignore struct {
 	ID    string   `yaml:"id" json:"id" toml:"id"`
@@ -15,5 +13,5 @@ func (i Ignore) shouldSkipRule(
 	if i.ID != ruleID {
 		return false
 	}
-	return stringsutil.ContainsCrossPlatformPathInSlice(displayPath, i.Files)
+	return pathPatterns(i.Files).matchFile(displayPath)
 }
@@ -6,9 +6,9 @@ import (
 
 // ImportsSortedOption represents the option for the IMPORTS_SORTED rule.
 type ImportsSortedOption struct {
-	CustomizableSeverityOption
+	CustomizableSeverityOption `yaml:",inline"`
 	// Deprecated: not used
-	Newline string
+	Newline string `yaml:"newline"`
 }
 
 // UnmarshalYAML implements yaml.v2 Unmarshaler interface.
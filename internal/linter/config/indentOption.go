@@ -7,11 +7,11 @@ import (
 
 // IndentOption represents the option for the INDENT rule.
 type IndentOption struct {
-	CustomizableSeverityOption
-	Style string
+	CustomizableSeverityOption `yaml:",inline"`
+	Style                      string `yaml:"style"`
 	// Deprecated: not used
-	Newline          string
-	NotInsertNewline bool
+	Newline          string `yaml:"newline"`
+	NotInsertNewline bool   `yaml:"not_insert_newline"`
 }
 
 // UnmarshalYAML implements yaml.v2 Unmarshaler interface.
@@ -11,12 +11,29 @@ type jsonConfigLoader struct {
 	filePath string
 }
 
-func (j jsonConfigLoader) LoadExternalConfig() (*ExternalConfig, error) {
+func (j jsonConfigLoader) LoadExternalConfig(settings []Setting) (*ExternalConfig, error) {
 	data, err := loadFileContent(j.filePath)
 	if err != nil {
 		return nil, err
 	}
 
+	if 0 < len(settings) {
+		var doc interface{}
+		if err := json.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		lint := lintDocument(doc, "protolint")
+		if lint == nil {
+			return nil, nil
+		}
+		data, err = json.Marshal(map[string]interface{}{
+			"protolint": applySettings(lint, settings),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	var config ExternalConfig
 	var jsonData jsonEmbeddedConfig
 	// do not unmarshal strict. JS specific package.json will contain
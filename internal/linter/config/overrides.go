@@ -0,0 +1,236 @@
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// severityKey is the option key to change the severity of any rule.
+const severityKey = "severity"
+
+// Override represents the rule configuration applied only to the matching files.
+//
+// Rules is keyed by the rule ID and takes the same options as rules_option,
+// like {"FIELDS_HAVE_COMMENT": {"severity": "warning"}}.
+type Override struct {
+	Files []string                          `yaml:"files" json:"files" toml:"files"`
+	Rules map[string]map[string]interface{} `yaml:"rules" json:"rules" toml:"rules"`
+}
+
+func (o Override) matchFile(
+	displayPath string,
+) bool {
+	return pathPatterns(o.Files).matchFile(displayPath)
+}
+
+func (o Override) validate() error {
+	if err := pathPatterns(o.Files).validate(); err != nil {
+		return err
+	}
+
+	for ruleID, values := range o.Rules {
+		if severity, ok := values[severityKey]; ok {
+			if _, err := toSeverity(severity); err != nil {
+				return fmt.Errorf("%s: %v", ruleID, err)
+			}
+		}
+		if _, ok := ruleOptionKey(ruleID); !ok {
+			for key := range values {
+				if key != severityKey {
+					return fmt.Errorf("%s: only severity can be overridden for the rule without rules_option", ruleID)
+				}
+			}
+			continue
+		}
+
+		var option RulesOption
+		if err := option.override(ruleID, values); err != nil {
+			return fmt.Errorf("%s: %v", ruleID, err)
+		}
+	}
+	return nil
+}
+
+// Overrides represents the list of overrides. The latter ones take precedence.
+type Overrides []Override
+
+func (os Overrides) rulesOption(
+	base RulesOption,
+	displayPath string,
+) (RulesOption, error) {
+	option := base
+	for _, o := range os {
+		if !o.matchFile(displayPath) {
+			continue
+		}
+		for ruleID, values := range o.Rules {
+			if _, ok := ruleOptionKey(ruleID); !ok {
+				continue
+			}
+			if err := option.override(ruleID, values); err != nil {
+				return RulesOption{}, fmt.Errorf("%s: %v", ruleID, err)
+			}
+		}
+	}
+	return option, nil
+}
+
+// validateRuleIDs checks whether the overrides name only the known rules.
+func (os Overrides) validateRuleIDs(
+	ruleIDs []string,
+) error {
+	known := make(map[string]bool)
+	for _, id := range ruleIDs {
+		known[id] = true
+	}
+	for _, o := range os {
+		for ruleID := range o.Rules {
+			if !known[ruleID] {
+				return fmt.Errorf("%s is an unknown rule", ruleID)
+			}
+		}
+	}
+	return nil
+}
+
+func (os Overrides) severity(
+	ruleID string,
+	displayPath string,
+) (rule.Severity, bool) {
+	var severity rule.Severity
+	found := false
+	for _, o := range os {
+		if !o.matchFile(displayPath) {
+			continue
+		}
+		value, ok := o.Rules[ruleID][severityKey]
+		if !ok {
+			continue
+		}
+		s, err := toSeverity(value)
+		if err != nil {
+			continue
+		}
+		severity = s
+		found = true
+	}
+	return severity, found
+}
+
+func toSeverity(value interface{}) (rule.Severity, error) {
+	s, ok := value.(string)
+	if !ok {
+		return "", fmt.Errorf("%v is an invalid severity", value)
+	}
+	switch severity := rule.Severity(s); severity {
+	case rule.SeverityNote, rule.SeverityWarning, rule.SeverityError:
+		return severity, nil
+	}
+	return "", fmt.Errorf("%s is an invalid severity. valid option is note, warning or error", s)
+}
+
+// ruleOptionKeyExceptions lists the rules_option keys which don't follow the rule IDs.
+var ruleOptionKeyExceptions = map[string]string{
+	"SERVICE_NAMES_UPPER_CAMEL_CASE": "service_names_upper_caml_case",
+}
+
+// ruleOptionKey returns the key of rules_option for the rule.
+func ruleOptionKey(ruleID string) (string, bool) {
+	key, ok := ruleOptionKeyExceptions[ruleID]
+	if !ok {
+		key = strings.ToLower(ruleID)
+	}
+
+	t := reflect.TypeOf(RulesOption{})
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("yaml") == key {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+// RuleOptionKeys returns the key of rules_option for the rule and the keys of the options under it.
+// It returns false if the rule has no rules_option.
+func RuleOptionKeys(ruleID string) (string, []string, bool) {
+	key, ok := ruleOptionKey(ruleID)
+	if !ok {
+		return "", nil, false
+	}
+
+	t := reflect.TypeOf(RulesOption{})
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).Tag.Get("yaml") == key {
+			return key, yamlKeys(t.Field(i).Type), true
+		}
+	}
+	return "", nil, false
+}
+
+// yamlKeys returns the yaml keys of the struct fields, including the ones of the embedded structs.
+func yamlKeys(t reflect.Type) []string {
+	var keys []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+		switch {
+		case f.Anonymous && len(name) == 0:
+			keys = append(keys, yamlKeys(f.Type)...)
+		case 0 < len(name):
+			keys = append(keys, name)
+		}
+	}
+	return keys
+}
+
+// override overwrites the options of the rule which the values specify, keeping the others.
+func (o *RulesOption) override(
+	ruleID string,
+	values map[string]interface{},
+) error {
+	key, ok := ruleOptionKey(ruleID)
+	if !ok {
+		return fmt.Errorf("not found rules_option for %s", ruleID)
+	}
+
+	// Decode the values in the same way as rules_option to reuse the custom unmarshalers.
+	data, err := yaml.Marshal(map[string]interface{}{key: values})
+	if err != nil {
+		return err
+	}
+	var patch RulesOption
+	if err := yaml.UnmarshalStrict(data, &patch); err != nil {
+		return err
+	}
+
+	dst := reflect.ValueOf(o).Elem()
+	src := reflect.ValueOf(patch)
+	for i := 0; i < dst.NumField(); i++ {
+		if dst.Type().Field(i).Tag.Get("yaml") == key {
+			setKeys(dst.Field(i), src.Field(i), values)
+		}
+	}
+	return nil
+}
+
+// setKeys sets the fields of src whose yaml keys are in values to dst, including the ones of the embedded structs.
+// The zero values are set as well, so that the override can turn off the option.
+func setKeys(dst, src reflect.Value, values map[string]interface{}) {
+	t := src.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := strings.Split(f.Tag.Get("yaml"), ",")[0]
+		if f.Anonymous && len(name) == 0 {
+			setKeys(dst.Field(i), src.Field(i), values)
+			continue
+		}
+		if _, ok := values[name]; ok {
+			dst.Field(i).Set(src.Field(i))
+		}
+	}
+}
@@ -0,0 +1,272 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+const overridesConfig = `
+lint:
+  rules_option:
+    max_line_length:
+      max_chars: 100
+      tab_chars: 2
+    indent:
+      style: tab
+    fields_have_comment:
+      should_follow_golang_style: true
+  overrides:
+    - files:
+        - legacy/**
+      rules:
+        FIELDS_HAVE_COMMENT:
+          severity: warning
+          should_follow_golang_style: false
+        MAX_LINE_LENGTH:
+          max_chars: 150
+        MY_PLUGIN_RULE:
+          severity: note
+    - files:
+        - legacy/generated/**
+      rules:
+        FIELDS_HAVE_COMMENT:
+          severity: note
+        INDENT:
+          severity: warning
+`
+
+func TestExternalConfig_Overrides(t *testing.T) {
+	var externalConfig config.ExternalConfig
+	err := yaml.UnmarshalStrict([]byte(overridesConfig), &externalConfig)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	for _, test := range []struct {
+		name              string
+		inputRuleID       string
+		inputDisplayPath  string
+		wantRulesOption   func(config.RulesOption) config.RulesOption
+		wantSeverity      rule.Severity
+		wantFoundSeverity bool
+	}{
+		{
+			name:             "no overrides for the unmatched file",
+			inputRuleID:      "FIELDS_HAVE_COMMENT",
+			inputDisplayPath: "path/to/new.proto",
+			wantRulesOption: func(o config.RulesOption) config.RulesOption {
+				return o
+			},
+		},
+		{
+			name:             "override the severity and the option for the matched file",
+			inputRuleID:      "FIELDS_HAVE_COMMENT",
+			inputDisplayPath: "legacy/old.proto",
+			wantRulesOption: func(o config.RulesOption) config.RulesOption {
+				o.FieldsHaveComment.Severity = rule.SeverityWarning
+				o.FieldsHaveComment.ShouldFollowGolangStyle = false
+				o.MaxLineLength.MaxChars = 150
+				return o
+			},
+			wantSeverity:      rule.SeverityWarning,
+			wantFoundSeverity: true,
+		},
+		{
+			name:             "the latter override takes precedence while keeping the unspecified options",
+			inputRuleID:      "FIELDS_HAVE_COMMENT",
+			inputDisplayPath: "legacy/generated/old.proto",
+			wantRulesOption: func(o config.RulesOption) config.RulesOption {
+				o.FieldsHaveComment.Severity = rule.SeverityNote
+				o.FieldsHaveComment.ShouldFollowGolangStyle = false
+				o.MaxLineLength.MaxChars = 150
+				o.Indent.Severity = rule.SeverityWarning
+				return o
+			},
+			wantSeverity:      rule.SeverityNote,
+			wantFoundSeverity: true,
+		},
+		{
+			name:             "override the severity of the rule without rules_option",
+			inputRuleID:      "MY_PLUGIN_RULE",
+			inputDisplayPath: "legacy/old.proto",
+			wantRulesOption: func(o config.RulesOption) config.RulesOption {
+				o.FieldsHaveComment.Severity = rule.SeverityWarning
+				o.FieldsHaveComment.ShouldFollowGolangStyle = false
+				o.MaxLineLength.MaxChars = 150
+				return o
+			},
+			wantSeverity:      rule.SeverityNote,
+			wantFoundSeverity: true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := externalConfig.RulesOptionFor(test.inputDisplayPath)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			want := test.wantRulesOption(externalConfig.Lint.RulesOption)
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("got %v, but want %v", got, want)
+			}
+			if test.inputDisplayPath == "path/to/new.proto" && !got.FieldsHaveComment.ShouldFollowGolangStyle {
+				t.Errorf("got %v, but want should_follow_golang_style kept true", got)
+			}
+			if got.Indent.Style != "\t" || got.MaxLineLength.TabChars != 2 {
+				t.Errorf("got %v, but want the unspecified options kept", got)
+			}
+
+			gotSeverity, gotFound := externalConfig.SeverityFor(test.inputRuleID, test.inputDisplayPath)
+			if gotSeverity != test.wantSeverity || gotFound != test.wantFoundSeverity {
+				t.Errorf("got %v, %v, but want %v, %v", gotSeverity, gotFound, test.wantSeverity, test.wantFoundSeverity)
+			}
+		})
+	}
+}
+
+func TestExternalConfig_Overrides_optionWithCustomUnmarshaler(t *testing.T) {
+	var externalConfig config.ExternalConfig
+	err := yaml.UnmarshalStrict([]byte(`
+lint:
+  rules_option:
+    indent:
+      style: 2
+  overrides:
+    - files:
+        - legacy/**
+      rules:
+        INDENT:
+          style: tab
+          not_insert_newline: true
+`), &externalConfig)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	got, err := externalConfig.RulesOptionFor("legacy/old.proto")
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if got.Indent.Style != "\t" || !got.Indent.NotInsertNewline {
+		t.Errorf("got %v, but want the overridden style and not_insert_newline", got.Indent)
+	}
+
+	got, err = externalConfig.RulesOptionFor("path/to/new.proto")
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if got.Indent.Style != "  " || got.Indent.NotInsertNewline {
+		t.Errorf("got %v, but want the base options", got.Indent)
+	}
+}
+
+func TestGetExternalConfig_InvalidOverrides(t *testing.T) {
+	for _, test := range []struct {
+		name        string
+		inputConfig string
+		wantErr     string
+	}{
+		{
+			name: "invalid severity",
+			inputConfig: `
+lint:
+  overrides:
+    - files: [legacy/**]
+      rules:
+        FIELDS_HAVE_COMMENT:
+          severity: fatal
+`,
+			wantErr: "fatal is an invalid severity",
+		},
+		{
+			name: "unknown option",
+			inputConfig: `
+lint:
+  overrides:
+    - files: [legacy/**]
+      rules:
+        MAX_LINE_LENGTH:
+          max_lines: 100
+`,
+			wantErr: "max_lines",
+		},
+		{
+			name: "options for the rule without rules_option",
+			inputConfig: `
+lint:
+  overrides:
+    - files: [legacy/**]
+      rules:
+        MY_PLUGIN_RULE:
+          max_chars: 100
+`,
+			wantErr: "only severity can be overridden",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), ".protolint.yaml")
+			if err := os.WriteFile(path, []byte(test.inputConfig), 0644); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			_, err := config.GetExternalConfig(path, "")
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("got err %v, but want err containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestExternalConfig_ValidateOverrideRuleIDs(t *testing.T) {
+	var externalConfig config.ExternalConfig
+	err := yaml.UnmarshalStrict([]byte(overridesConfig), &externalConfig)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	for _, test := range []struct {
+		name         string
+		inputRuleIDs []string
+		wantErr      string
+	}{
+		{
+			name:         "all rules are known",
+			inputRuleIDs: []string{"FIELDS_HAVE_COMMENT", "MAX_LINE_LENGTH", "MY_PLUGIN_RULE", "INDENT"},
+		},
+		{
+			name:         "the plugin rule isn't loaded",
+			inputRuleIDs: []string{"FIELDS_HAVE_COMMENT", "MAX_LINE_LENGTH", "INDENT"},
+			wantErr:      "MY_PLUGIN_RULE is an unknown rule",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			err := externalConfig.ValidateOverrideRuleIDs(test.inputRuleIDs)
+			if test.wantErr == "" {
+				if err != nil {
+					t.Errorf("got err %v, but want nil", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("got err %v, but want err containing %q", err, test.wantErr)
+			}
+		})
+	}
+}
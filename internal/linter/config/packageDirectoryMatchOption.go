@@ -0,0 +1,8 @@
+package config
+
+// PackageDirectoryMatchOption represents the option for the PACKAGE_DIRECTORY_MATCH rule.
+type PackageDirectoryMatchOption struct {
+	CustomizableSeverityOption `yaml:",inline"`
+	// Root is the directory which the package names are relative to. The default is the import path containing the file.
+	Root string `yaml:"root" json:"root" toml:"root"`
+}
@@ -0,0 +1,90 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/maramkhaledn/protolint/internal/filepathutil"
+)
+
+// negationPrefix is the prefix to re-include the paths matched by the former entries.
+const negationPrefix = "!"
+
+// pathPatterns represents a list of literal paths, glob patterns, regular expressions
+// and their negations, like []string{"**/third_party/**", "!**/third_party/ours/**"}.
+//
+// The entries are evaluated in order and the last matching entry wins.
+type pathPatterns []string
+
+// match checks whether the displayPath is matched by the list.
+// matchLiteral is used for the literal entries and matchPattern for the others.
+func (ps pathPatterns) match(
+	displayPath string,
+	matchLiteral func(unixPath, displayPath string) bool,
+	matchPattern func(pattern, displayPath string) bool,
+) bool {
+	matched := false
+	for _, p := range ps {
+		negated := false
+		if rest, ok := strings.CutPrefix(p, negationPrefix); ok {
+			negated = true
+			p = rest
+		}
+		if matched == !negated {
+			// The entry can't change the result.
+			continue
+		}
+
+		var ok bool
+		if filepathutil.IsUnixPathPattern(p) {
+			ok = matchPattern(p, displayPath)
+		} else {
+			ok = matchLiteral(p, displayPath)
+		}
+		if ok {
+			matched = !negated
+		}
+	}
+	return matched
+}
+
+// matchFile checks whether the displayPath of a file is matched by the list.
+func (ps pathPatterns) matchFile(
+	displayPath string,
+) bool {
+	return ps.match(displayPath, filepathutil.IsSameUnixPath, filepathutil.MatchUnixPathPattern)
+}
+
+// matchDirectory checks whether any parent directory of the displayPath is matched by the list.
+func (ps pathPatterns) matchDirectory(
+	displayPath string,
+) bool {
+	return ps.match(displayPath, func(unixPath, displayPath string) bool {
+		if !strings.HasSuffix(unixPath, string(filepathutil.OSPathSeparator)) {
+			unixPath += string(filepathutil.OSPathSeparator)
+		}
+		return filepathutil.HasUnixPathPrefix(displayPath, unixPath)
+	}, func(pattern, displayPath string) bool {
+		unixPath := filepathutil.ToUnixPath(displayPath)
+		for dir := path.Dir(unixPath); dir != "." && dir != path.Dir(dir); dir = path.Dir(dir) {
+			if filepathutil.MatchUnixPathPattern(pattern, dir) {
+				return true
+			}
+		}
+		return filepathutil.MatchUnixPathPattern(pattern, unixPath)
+	})
+}
+
+func (ps pathPatterns) validate() error {
+	for _, p := range ps {
+		p = strings.TrimPrefix(p, negationPrefix)
+		if !filepathutil.IsUnixPathPattern(p) {
+			continue
+		}
+		if err := filepathutil.ValidateUnixPathPattern(p); err != nil {
+			return fmt.Errorf("invalid pattern %q: %v", p, err)
+		}
+	}
+	return nil
+}
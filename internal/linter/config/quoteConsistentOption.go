@@ -16,8 +16,8 @@ const (
 
 // QuoteConsistentOption represents the option for the QUOTE_CONSISTENT rule.
 type QuoteConsistentOption struct {
-	CustomizableSeverityOption
-	Quote QuoteType
+	CustomizableSeverityOption `yaml:",inline"`
+	Quote                      QuoteType `yaml:"quote"`
 }
 
 // UnmarshalYAML implements yaml.v2 Unmarshaler interface.
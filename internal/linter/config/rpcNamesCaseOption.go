@@ -17,8 +17,8 @@ const (
 
 // RPCNamesCaseOption represents the option for the RPC_NAMES_CASE rule.
 type RPCNamesCaseOption struct {
-	CustomizableSeverityOption
-	Convention ConventionType
+	CustomizableSeverityOption `yaml:",inline"`
+	Convention                 ConventionType `yaml:"convention"`
 }
 
 // UnmarshalYAML implements yaml.v2 Unmarshaler interface.
@@ -33,4 +33,8 @@ type RulesOption struct {
 	RPCNamesUpperCamelCase          CustomizableSeverityOption            `yaml:"rpc_names_upper_camel_case" json:"rpc_names_upper_camel_case" toml:"rpc_names_upper_camel_case"`
 	ServiceNamesUpperCamelCase      CustomizableSeverityOption            `yaml:"service_names_upper_caml_case" json:"service_names_upper_caml_case" toml:"service_names_upper_caml_case"`
 	RPCVersioning                   CustomizableSeverityOption            `yaml:"rpc_versioning" json:"rpc_versioning" toml:"rpc_versioning"`
+	ReferencesResolved              CustomizableSeverityOption            `yaml:"references_resolved" json:"references_resolved" toml:"references_resolved"`
+	ImportsUsed                     CustomizableSeverityOption            `yaml:"imports_used" json:"imports_used" toml:"imports_used"`
+	ImportsAcyclic                  CustomizableSeverityOption            `yaml:"imports_acyclic" json:"imports_acyclic" toml:"imports_acyclic"`
+	PackageDirectoryMatch           PackageDirectoryMatchOption           `yaml:"package_directory_match" json:"package_directory_match" toml:"package_directory_match"`
 }
@@ -0,0 +1,163 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/maramkhaledn/protolint/internal/stringsutil"
+)
+
+// EnvPrefix is the prefix of the environment variables to set the config values.
+// The keys are separated by "__", like PROTOLINT_RULES_OPTION__MAX_LINE_LENGTH__MAX_CHARS=120.
+const EnvPrefix = "PROTOLINT_"
+
+const envKeySeparator = "__"
+
+// lintKeys are the top-level keys under lint. Only the environment variables
+// starting with them are regarded as settings.
+var lintKeys = []string{
+	"ignores",
+	"files",
+	"directories",
+	"rules",
+	"rules_option",
+	"overrides",
+	"max_warnings",
+}
+
+// Setting represents a config value set by a key path under lint,
+// like rules_option.max_line_length.max_chars.
+type Setting struct {
+	Keys  []string
+	Value interface{}
+}
+
+// ParseSetting parses the "key.path=value" notation.
+// The value is parsed as YAML, so that "120", "true" and "[a, b]" are typed accordingly.
+func ParseSetting(s string) (Setting, error) {
+	path, value, ok := strings.Cut(s, "=")
+	if !ok {
+		return Setting{}, fmt.Errorf("%s must be in the form of key.path=value", s)
+	}
+	return newSetting(strings.Split(path, "."), value)
+}
+
+// SettingsFromEnv parses the environment variables prefixed with EnvPrefix.
+// The environ is in the form of os.Environ() and the settings are sorted by the names.
+func SettingsFromEnv(environ []string) ([]Setting, error) {
+	var names []string
+	values := make(map[string]string)
+	for _, env := range environ {
+		name, value, ok := strings.Cut(env, "=")
+		if !ok || !strings.HasPrefix(name, EnvPrefix) {
+			continue
+		}
+		names = append(names, name)
+		values[name] = value
+	}
+	sort.Strings(names)
+
+	var settings []Setting
+	for _, name := range names {
+		keys := strings.Split(strings.ToLower(strings.TrimPrefix(name, EnvPrefix)), envKeySeparator)
+		if !stringsutil.ContainsStringInSlice(keys[0], lintKeys) {
+			continue
+		}
+		setting, err := newSetting(keys, values[name])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		settings = append(settings, setting)
+	}
+	return settings, nil
+}
+
+func newSetting(keys []string, value string) (Setting, error) {
+	for _, k := range keys {
+		if len(k) == 0 {
+			return Setting{}, fmt.Errorf("%s has an empty key", strings.Join(keys, "."))
+		}
+	}
+
+	var v interface{}
+	if err := yaml.Unmarshal([]byte(value), &v); err != nil {
+		return Setting{}, err
+	}
+	if v == nil {
+		v = value
+	}
+	return Setting{
+		Keys:  keys,
+		Value: normalize(v),
+	}, nil
+}
+
+// applySettings sets the values to the lint document in order.
+func applySettings(
+	lint map[string]interface{},
+	settings []Setting,
+) map[string]interface{} {
+	if lint == nil {
+		lint = make(map[string]interface{})
+	}
+	for _, s := range settings {
+		m := lint
+		for _, k := range s.Keys[:len(s.Keys)-1] {
+			child, ok := m[k].(map[string]interface{})
+			if !ok {
+				child = make(map[string]interface{})
+				m[k] = child
+			}
+			m = child
+		}
+		m[s.Keys[len(s.Keys)-1]] = s.Value
+	}
+	return lint
+}
+
+// normalize converts the decoded maps to map[string]interface{} recursively
+// so that the document can be encoded in any format.
+func normalize(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{})
+		for k, e := range v {
+			m[fmt.Sprint(k)] = normalize(e)
+		}
+		return m
+	case map[string]interface{}:
+		m := make(map[string]interface{})
+		for k, e := range v {
+			m[k] = normalize(e)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = normalize(e)
+		}
+		return l
+	case []map[string]interface{}:
+		l := make([]interface{}, len(v))
+		for i, e := range v {
+			l[i] = normalize(e)
+		}
+		return l
+	}
+	return v
+}
+
+// lintDocument returns the normalized lint document under the key of the decoded document.
+func lintDocument(
+	doc interface{},
+	keys ...string,
+) map[string]interface{} {
+	m, _ := normalize(doc).(map[string]interface{})
+	for _, k := range keys {
+		m, _ = m[k].(map[string]interface{})
+	}
+	return m
+}
@@ -0,0 +1,280 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestParseSetting(t *testing.T) {
+	for _, test := range []struct {
+		name         string
+		input        string
+		wantSetting  config.Setting
+		wantExistErr bool
+	}{
+		{
+			name:  "an integer value",
+			input: "rules_option.max_line_length.max_chars=120",
+			wantSetting: config.Setting{
+				Keys:  []string{"rules_option", "max_line_length", "max_chars"},
+				Value: 120,
+			},
+		},
+		{
+			name:  "a list value",
+			input: "files.exclude=[a.proto, b.proto]",
+			wantSetting: config.Setting{
+				Keys:  []string{"files", "exclude"},
+				Value: []interface{}{"a.proto", "b.proto"},
+			},
+		},
+		{
+			name:  "an empty value",
+			input: "rules_option.indent.style=",
+			wantSetting: config.Setting{
+				Keys:  []string{"rules_option", "indent", "style"},
+				Value: "",
+			},
+		},
+		{
+			name:         "no value",
+			input:        "rules_option.max_line_length.max_chars",
+			wantExistErr: true,
+		},
+		{
+			name:         "an empty key",
+			input:        "rules_option..max_chars=120",
+			wantExistErr: true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := config.ParseSetting(test.input)
+			if test.wantExistErr {
+				if err == nil {
+					t.Errorf("got err nil, but want err")
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if !reflect.DeepEqual(got, test.wantSetting) {
+				t.Errorf("got %v, but want %v", got, test.wantSetting)
+			}
+		})
+	}
+}
+
+func TestSettingsFromEnv(t *testing.T) {
+	got, err := config.SettingsFromEnv([]string{
+		"PROTOLINT_RULES_OPTION__MAX_LINE_LENGTH__TAB_CHARS=4",
+		"PROTOLINT_CIREPORTER_TEMPLATE_STRING={{ .Message }}",
+		"HOME=/root",
+		"PROTOLINT_MAX_WARNINGS=10",
+	})
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	want := []config.Setting{
+		{
+			Keys:  []string{"max_warnings"},
+			Value: 10,
+		},
+		{
+			Keys:  []string{"rules_option", "max_line_length", "tab_chars"},
+			Value: 4,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
+
+func TestGetExternalConfigWithSettings(t *testing.T) {
+	settings := []config.Setting{
+		{
+			Keys:  []string{"rules_option", "max_line_length", "max_chars"},
+			Value: 100,
+		},
+		{
+			Keys:  []string{"rules_option", "max_line_length", "tab_chars"},
+			Value: 2,
+		},
+		{
+			Keys:  []string{"rules_option", "max_line_length", "max_chars"},
+			Value: 120,
+		},
+	}
+	wantIgnores := config.Ignores{
+		{
+			ID:    "ENUM_NAMES_UPPER_CAMEL_CASE",
+			Files: []string{"path/to/foo.proto"},
+		},
+	}
+	wantMaxLineLength := config.MaxLineLengthOption{
+		CustomizableSeverityOption: config.CustomizableSeverityOption{
+			Severity: rule.SeverityWarning,
+		},
+		MaxChars: 120,
+		TabChars: 2,
+	}
+
+	for _, test := range []struct {
+		name          string
+		inputFileName string
+		inputContent  string
+	}{
+		{
+			name:          "yaml",
+			inputFileName: ".protolint.yaml",
+			inputContent: `
+lint:
+  ignores:
+    - id: ENUM_NAMES_UPPER_CAMEL_CASE
+      files:
+        - path/to/foo.proto
+  rules_option:
+    max_line_length:
+      severity: warning
+      max_chars: 80
+`,
+		},
+		{
+			name:          "package.json",
+			inputFileName: "package.json",
+			inputContent: `{
+  "name": "example",
+  "protolint": {
+    "ignores": [
+      {"id": "ENUM_NAMES_UPPER_CAMEL_CASE", "files": ["path/to/foo.proto"]}
+    ],
+    "rules_option": {
+      "max_line_length": {"severity": "warning", "max_chars": 80}
+    }
+  }
+}`,
+		},
+		{
+			name:          "pyproject.toml",
+			inputFileName: "pyproject.toml",
+			inputContent: `
+[project]
+name = "example"
+
+[[tools.protolint.ignores]]
+id = "ENUM_NAMES_UPPER_CAMEL_CASE"
+files = ["path/to/foo.proto"]
+
+[tools.protolint.rules_option.max_line_length]
+severity = "warning"
+max_chars = 80
+`,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), test.inputFileName)
+			if err := os.WriteFile(path, []byte(test.inputContent), 0644); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			got, err := config.GetExternalConfigWithSettings(path, "", settings)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			if got.SourcePath != path {
+				t.Errorf("got %v, but want %v", got.SourcePath, path)
+			}
+			if !reflect.DeepEqual(got.Lint.Ignores, wantIgnores) {
+				t.Errorf("got %v, but want %v", got.Lint.Ignores, wantIgnores)
+			}
+			if !reflect.DeepEqual(got.Lint.RulesOption.MaxLineLength, wantMaxLineLength) {
+				t.Errorf("got %v, but want %v", got.Lint.RulesOption.MaxLineLength, wantMaxLineLength)
+			}
+		})
+	}
+
+	t.Run("no config file", func(t *testing.T) {
+		prevDir, err := os.Getwd()
+		if err != nil {
+			t.Errorf("got err %v", err)
+			return
+		}
+		defer func() {
+			_ = os.Chdir(prevDir)
+		}()
+		if err := os.Chdir(t.TempDir()); err != nil {
+			t.Errorf("got err %v", err)
+			return
+		}
+
+		got, err := config.GetExternalConfigWithSettings("", "", settings)
+		if err != nil {
+			t.Errorf("got err %v", err)
+			return
+		}
+		if got.Lint.RulesOption.MaxLineLength.MaxChars != 120 {
+			t.Errorf("got %v, but want 120", got.Lint.RulesOption.MaxLineLength.MaxChars)
+		}
+	})
+}
+
+func TestGetExternalConfigWithSettings_negativeMaxWarnings(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".protolint.yaml")
+	if err := os.WriteFile(path, []byte("lint:\n  max_warnings: 10\n"), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	for _, test := range []struct {
+		name          string
+		inputSettings []config.Setting
+		wantErr       bool
+	}{
+		{
+			name: "zero",
+			inputSettings: []config.Setting{
+				{
+					Keys:  []string{"max_warnings"},
+					Value: 0,
+				},
+			},
+		},
+		{
+			name: "negative",
+			inputSettings: []config.Setting{
+				{
+					Keys:  []string{"max_warnings"},
+					Value: -1,
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := config.GetExternalConfigWithSettings(path, "", test.inputSettings)
+			if test.wantErr {
+				if err == nil || !strings.Contains(err.Error(), "max_warnings must not be negative") {
+					t.Errorf("got err %v, but want the negative max_warnings", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("got err %v", err)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package config
 
 import (
+	"bytes"
+
 	"github.com/BurntSushi/toml"
 )
 
@@ -11,12 +13,34 @@ type tomlConfigLoader struct {
 	filePath string
 }
 
-func (t tomlConfigLoader) LoadExternalConfig() (*ExternalConfig, error) {
+func (t tomlConfigLoader) LoadExternalConfig(settings []Setting) (*ExternalConfig, error) {
 	data, err := loadFileContent(t.filePath)
 	if err != nil {
 		return nil, err
 	}
 
+	if 0 < len(settings) {
+		var doc map[string]interface{}
+		if err := toml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		lint := lintDocument(doc, "tools", "protolint")
+		if lint == nil {
+			return nil, nil
+		}
+
+		var buf bytes.Buffer
+		err = toml.NewEncoder(&buf).Encode(map[string]interface{}{
+			"tools": map[string]interface{}{
+				"protolint": applySettings(lint, settings),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+		data = buf.Bytes()
+	}
+
 	var config ExternalConfig
 	var tomlData tomlToolsEmbeddedConfig
 	// do not unmarshal strict. JS specific package.json will contain
@@ -15,19 +15,45 @@ type yamlConfigLoader struct {
 	filePath string
 }
 
-func (y yamlConfigLoader) LoadExternalConfig() (*ExternalConfig, error) {
+func (y yamlConfigLoader) LoadExternalConfig(settings []Setting) (*ExternalConfig, error) {
 	data, err := loadFileContent(y.filePath)
 	if err != nil {
 		return nil, err
 	}
 
-	var config ExternalConfig
-
-	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+	config, err := decodeYAMLConfig(data, settings)
+	if err != nil {
 		return nil, err
 	}
 
 	config.SourcePath = y.filePath
 
+	return config, nil
+}
+
+func decodeYAMLConfig(data []byte, settings []Setting) (*ExternalConfig, error) {
+	if 0 < len(settings) {
+		var doc interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return nil, err
+		}
+		m := lintDocument(doc)
+		if m == nil {
+			m = make(map[string]interface{})
+		}
+		m["lint"] = applySettings(lintDocument(doc, "lint"), settings)
+
+		var err error
+		data, err = yaml.Marshal(m)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var config ExternalConfig
+
+	if err := yaml.UnmarshalStrict(data, &config); err != nil {
+		return nil, err
+	}
 	return &config, nil
 }
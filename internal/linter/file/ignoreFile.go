@@ -0,0 +1,193 @@
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bmatcuk/doublestar/v4"
+)
+
+// IgnoreFileName is the name of the file to list the paths protolint ignores.
+// It follows the .gitignore syntax and can be placed at any level of the tree.
+const IgnoreFileName = ".protolintignore"
+
+// ignorePattern is a line of the ignore file.
+type ignorePattern struct {
+	// The glob pattern relative to the directory of the ignore file.
+	pattern string
+	negated bool
+	dirOnly bool
+}
+
+func parseIgnorePatterns(content string) []ignorePattern {
+	var patterns []ignorePattern
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.TrimRight(line, "\r")
+		if !strings.HasSuffix(line, `\ `) {
+			line = strings.TrimRight(line, " ")
+		}
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		var p ignorePattern
+		if strings.HasPrefix(line, "!") {
+			p.negated = true
+			line = line[1:]
+		} else if strings.HasPrefix(line, `\!`) || strings.HasPrefix(line, `\#`) {
+			line = line[1:]
+		}
+		if strings.HasSuffix(line, "/") {
+			p.dirOnly = true
+			line = strings.TrimSuffix(line, "/")
+		}
+		if len(line) == 0 {
+			continue
+		}
+
+		// A pattern without a slash matches at any level below the ignore file.
+		// Otherwise, it is relative to the directory of the ignore file.
+		if strings.Contains(line, "/") {
+			line = strings.TrimPrefix(line, "/")
+		} else {
+			line = "**/" + line
+		}
+		p.pattern = line
+		patterns = append(patterns, p)
+	}
+	return patterns
+}
+
+func (p ignorePattern) match(
+	unixRelPath string,
+	isDir bool,
+) bool {
+	if p.dirOnly && !isDir {
+		return false
+	}
+	matched, err := doublestar.Match(p.pattern, unixRelPath)
+	return err == nil && matched
+}
+
+// ignoreMatcher decides whether the paths are ignored by the ignore files.
+type ignoreMatcher struct {
+	// The ignore patterns per directory. nil means the directory has no ignore file.
+	patternsByDir map[string][]ignorePattern
+}
+
+func newIgnoreMatcher() *ignoreMatcher {
+	return &ignoreMatcher{
+		patternsByDir: make(map[string][]ignorePattern),
+	}
+}
+
+func (m *ignoreMatcher) patterns(
+	absDir string,
+) ([]ignorePattern, error) {
+	if ps, ok := m.patternsByDir[absDir]; ok {
+		return ps, nil
+	}
+
+	var ps []ignorePattern
+	content, err := os.ReadFile(filepath.Join(absDir, IgnoreFileName))
+	switch {
+	case err == nil:
+		ps = parseIgnorePatterns(string(content))
+	case !os.IsNotExist(err):
+		return nil, err
+	}
+	m.patternsByDir[absDir] = ps
+	return ps, nil
+}
+
+// isIgnored checks whether the path is ignored by the ignore files placed
+// in absRootDir and its subdirectories up to the parent of the path.
+//
+// As with .gitignore, the patterns in a deeper ignore file take precedence and
+// the last matching pattern in a file wins.
+func (m *ignoreMatcher) isIgnored(
+	absRootDir string,
+	absPath string,
+	isDir bool,
+) (bool, error) {
+	ignored := false
+	for _, dir := range ancestorDirs(absRootDir, filepath.Dir(absPath)) {
+		ps, err := m.patterns(dir)
+		if err != nil {
+			return false, err
+		}
+		if len(ps) == 0 {
+			continue
+		}
+
+		rel, err := filepath.Rel(dir, absPath)
+		if err != nil {
+			return false, err
+		}
+		rel = filepath.ToSlash(rel)
+		for _, p := range ps {
+			if p.negated == ignored && p.match(rel, isDir) {
+				ignored = !p.negated
+			}
+		}
+	}
+	return ignored, nil
+}
+
+// isExcluded checks whether the path or any of its parent directories below absRootDir is ignored.
+// As with .gitignore, it is not possible to re-include a path if its parent directory is ignored.
+func (m *ignoreMatcher) isExcluded(
+	absRootDir string,
+	absPath string,
+	isDir bool,
+) (bool, error) {
+	if absPath == absRootDir {
+		return false, nil
+	}
+	dirs := ancestorDirs(absRootDir, filepath.Dir(absPath))
+	if 0 < len(dirs) {
+		for _, dir := range dirs[1:] {
+			ignored, err := m.isIgnored(absRootDir, dir, true)
+			if err != nil || ignored {
+				return ignored, err
+			}
+		}
+	}
+	return m.isIgnored(absRootDir, absPath, isDir)
+}
+
+// ancestorDirs returns absRootDir and its subdirectories down to absDir.
+// It returns only absDir if absDir is not inside absRootDir.
+func ancestorDirs(
+	absRootDir string,
+	absDir string,
+) []string {
+	if !isInsideDir(absRootDir, absDir) {
+		return []string{absDir}
+	}
+
+	rel, _ := filepath.Rel(absRootDir, absDir)
+	dirs := []string{absRootDir}
+	if rel == "." {
+		return dirs
+	}
+	dir := absRootDir
+	for _, elem := range strings.Split(rel, string(filepath.Separator)) {
+		dir = filepath.Join(dir, elem)
+		dirs = append(dirs, dir)
+	}
+	return dirs
+}
+
+// isInsideDir checks whether absPath is absDir itself or inside it.
+func isInsideDir(
+	absDir string,
+	absPath string,
+) bool {
+	rel, err := filepath.Rel(absDir, absPath)
+	if err != nil {
+		return false
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -1,10 +1,10 @@
 package file
 
 import (
-	"os"
-
 	protoparser "github.com/yoheimuta/go-protoparser/v4"
 	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/osutil"
 )
 
 // ProtoFile is a Protocol Buffer file.
@@ -33,8 +33,16 @@ func NewProtoFile(
 // Parse parses a Protocol Buffer file.
 func (f ProtoFile) Parse(
 	debug bool,
+) (*parser.Proto, error) {
+	return f.ParseWithFiles(nil, debug)
+}
+
+// ParseWithFiles parses a Protocol Buffer file read through files.
+func (f ProtoFile) ParseWithFiles(
+	files *osutil.Files,
+	debug bool,
 ) (_ *parser.Proto, err error) {
-	reader, err := os.Open(f.path)
+	reader, err := files.Open(f.path)
 	if err != nil {
 		return nil, err
 	}
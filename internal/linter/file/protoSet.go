@@ -49,6 +49,7 @@ func collectAllProtoFilesFromArgs(
 		absCwd = newPath
 	}
 
+	ignores := newIgnoreMatcher()
 	var fs []ProtoFile
 	for _, path := range targetPaths {
 		absTarget, err := absClean(path)
@@ -56,7 +57,7 @@ func collectAllProtoFilesFromArgs(
 			return nil, err
 		}
 
-		f, err := collectAllProtoFiles(absCwd, absTarget)
+		f, err := collectAllProtoFiles(absCwd, absTarget, ignores)
 		if err != nil {
 			return nil, err
 		}
@@ -68,15 +69,43 @@ func collectAllProtoFilesFromArgs(
 func collectAllProtoFiles(
 	absWorkDirPath string,
 	absPath string,
+	ignores *ignoreMatcher,
 ) ([]ProtoFile, error) {
 	var fs []ProtoFile
 
+	// The ignore files are looked up from the working directory, or the target
+	// itself if it is outside the working directory.
+	absRootDir := absWorkDirPath
+	if !isInsideDir(absWorkDirPath, absPath) {
+		absRootDir = absPath
+		if info, err := os.Stat(absPath); err == nil && !info.IsDir() {
+			absRootDir = filepath.Dir(absPath)
+		}
+	}
+
 	err := filepath.Walk(
 		absPath,
 		func(path string, info os.FileInfo, err error) error {
 			if err != nil {
 				return err
 			}
+
+			var ignored bool
+			if path == absPath {
+				ignored, err = ignores.isExcluded(absRootDir, path, info.IsDir())
+			} else {
+				ignored, err = ignores.isIgnored(absRootDir, path, info.IsDir())
+			}
+			if err != nil {
+				return err
+			}
+			if ignored {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
 			if filepath.Ext(path) != ".proto" {
 				return nil
 			}
@@ -61,6 +61,37 @@ func TestNewProtoSet(t *testing.T) {
 				),
 			},
 		},
+		{
+			name: "ignoredir includes .protolintignore files",
+			inputTargetPaths: []string{
+				setting_test.TestDataPath("ignoredir"),
+			},
+			wantProtoFiles: []file.ProtoFile{
+				file.NewProtoFile(
+					filepath.Join(setting_test.TestDataPath("ignoredir"), "/a.proto"),
+					"../../../_testdata/ignoredir/a.proto",
+				),
+				file.NewProtoFile(
+					filepath.Join(setting_test.TestDataPath("ignoredir", "inner"), "/c.proto"),
+					"../../../_testdata/ignoredir/inner/c.proto",
+				),
+				file.NewProtoFile(
+					filepath.Join(setting_test.TestDataPath("ignoredir", "inner", "deep"), "/b.proto"),
+					"../../../_testdata/ignoredir/inner/deep/b.proto",
+				),
+				file.NewProtoFile(
+					filepath.Join(setting_test.TestDataPath("ignoredir"), "/keep_internal.proto"),
+					"../../../_testdata/ignoredir/keep_internal.proto",
+				),
+			},
+		},
+		{
+			name: "a file ignored by .protolintignore",
+			inputTargetPaths: []string{
+				setting_test.TestDataPath("ignoredir", "foo_internal.proto"),
+			},
+			wantExistErr: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -78,6 +109,10 @@ func TestNewProtoSet(t *testing.T) {
 				return
 			}
 
+			if len(got.ProtoFiles()) != len(test.wantProtoFiles) {
+				t.Errorf("got %d files, but want %d files", len(got.ProtoFiles()), len(test.wantProtoFiles))
+				return
+			}
 			for i, gotf := range got.ProtoFiles() {
 				wantf := test.wantProtoFiles[i]
 				if gotf.Path() != wantf.Path() {
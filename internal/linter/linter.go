@@ -1,28 +1,51 @@
 package linter
 
 import (
+	"sync"
+	"time"
+
 	"github.com/yoheimuta/go-protoparser/v4/parser"
 
+	internalrule "github.com/maramkhaledn/protolint/internal/linter/rule"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
 )
 
 // Linter represents the protocol buffer linter with some rules.
-type Linter struct{}
+type Linter struct {
+	mu      sync.Mutex
+	elapsed map[string]time.Duration
+}
 
 // NewLinter creates a new Linter.
 func NewLinter() *Linter {
-	return &Linter{}
+	return &Linter{
+		elapsed: make(map[string]time.Duration),
+	}
 }
 
 // Run lints the protocol buffer.
 func (l *Linter) Run(
 	genProto func(*parser.Proto) (*parser.Proto, error),
 	hasApplies []rule.HasApply,
+) ([]report.Failure, error) {
+	return l.RunWithImporter(genProto, nil, hasApplies)
+}
+
+// RunWithImporter lints the protocol buffer like Run, and gives the rules implementing
+// internalrule.HasApplyWithContext the imports resolved by the importer.
+// The rules fall back to Apply if the importer is nil.
+func (l *Linter) RunWithImporter(
+	genProto func(*parser.Proto) (*parser.Proto, error),
+	importer *symbol.Importer,
+	hasApplies []rule.HasApply,
 ) ([]report.Failure, error) {
 	var fs []report.Failure
 	var p *parser.Proto
 	var err error
+	var ctx *symbol.Context
+	var ctxProto *parser.Proto
 
 	for _, hasApply := range hasApplies {
 		p, err = genProto(p)
@@ -30,7 +53,21 @@ func (l *Linter) Run(
 			return nil, err
 		}
 
-		f, err := hasApply.Apply(p)
+		needsContext := importer != nil && internalrule.NeedsContext(hasApply)
+		// The context is resolved again only after the proto is regenerated.
+		if needsContext && ctxProto != p {
+			ctx = importer.Context(p)
+			ctxProto = p
+		}
+
+		start := time.Now()
+		var f []report.Failure
+		if needsContext {
+			f, err = hasApply.(internalrule.HasApplyWithContext).ApplyWithContext(ctx, p)
+		} else {
+			f, err = hasApply.Apply(p)
+		}
+		l.record(hasApply, time.Since(start))
 		if err != nil {
 			return nil, err
 		}
@@ -38,3 +75,33 @@ func (l *Linter) Run(
 	}
 	return fs, nil
 }
+
+// Elapsed returns the time spent applying each rule in all runs so far, keyed by the rule ID.
+// It excludes the time to parse the files.
+func (l *Linter) Elapsed() map[string]time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	elapsed := make(map[string]time.Duration, len(l.elapsed))
+	for id, d := range l.elapsed {
+		elapsed[id] = d
+	}
+	return elapsed
+}
+
+func (l *Linter) record(
+	hasApply rule.HasApply,
+	d time.Duration,
+) {
+	r, ok := hasApply.(rule.HasID)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.elapsed == nil {
+		l.elapsed = make(map[string]time.Duration)
+	}
+	l.elapsed[r.ID()] += d
+}
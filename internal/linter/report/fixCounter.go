@@ -0,0 +1,52 @@
+package report
+
+import (
+	"sync"
+)
+
+// FixCounter counts the replacements which the fixable rules apply to the files with -fix.
+// A nil *FixCounter counts nothing.
+type FixCounter struct {
+	mu    sync.Mutex
+	count int
+}
+
+// NewFixCounter creates a new FixCounter.
+func NewFixCounter() *FixCounter {
+	return &FixCounter{}
+}
+
+// Add counts the replacements which changed the content before into after.
+func (c *FixCounter) Add(
+	before []byte,
+	after []byte,
+) {
+	if c == nil {
+		return
+	}
+	n := len(NewReplacements(string(before), string(after)))
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.count += n
+}
+
+// AddRename counts the rename of a file as a replacement.
+func (c *FixCounter) AddRename() {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.count++
+}
+
+// Count returns the number of the replacements counted so far.
+func (c *FixCounter) Count() int {
+	if c == nil {
+		return 0
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.count
+}
@@ -0,0 +1,39 @@
+package report
+
+import (
+	"sync"
+
+	"github.com/maramkhaledn/protolint/linter/report"
+)
+
+// FixRecorder collects the fixes which the fixable rules would make with -fix, while the files are linted without it.
+type FixRecorder struct {
+	mu    sync.Mutex
+	fixes []Fix
+}
+
+// NewFixRecorder creates a new FixRecorder.
+func NewFixRecorder() *FixRecorder {
+	return &FixRecorder{}
+}
+
+// Record records the replacements to fix the failure.
+func (r *FixRecorder) Record(
+	f report.Failure,
+	replacements []Replacement,
+) {
+	if len(replacements) == 0 {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.fixes = append(r.fixes, Fix{Failure: f, Replacements: replacements})
+}
+
+// Fixes returns the recorded fixes in order.
+func (r *FixRecorder) Fixes() []Fix {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Fix(nil), r.fixes...)
+}
@@ -0,0 +1,173 @@
+package report
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// maxDiffCells limits the size of the table to find the longest common lines.
+// The changed lines are regarded as a single hunk beyond that.
+const maxDiffCells = 4000000
+
+// Replacement represents the replacement of the text from the start to the end with Text.
+// The end is exclusive, so that the start equals the end for an insertion.
+// The lines and columns are 1-based and the columns count the runes.
+type Replacement struct {
+	StartLine   int
+	StartColumn int
+	EndLine     int
+	EndColumn   int
+	Text        string
+}
+
+// NewReplacements returns the line-based replacements which change before into after.
+func NewReplacements(
+	before string,
+	after string,
+) []Replacement {
+	if before == after {
+		return nil
+	}
+	bs := strings.Split(before, "\n")
+	as := strings.Split(after, "\n")
+
+	var replacements []Replacement
+	for _, h := range diffLines(bs, as) {
+		replacements = append(replacements, h.replacement(bs, as))
+	}
+	return replacements
+}
+
+// hunk represents the replacement of before[bStart:bEnd] with after[aStart:aEnd].
+type hunk struct {
+	bStart, bEnd int
+	aStart, aEnd int
+}
+
+func (h hunk) replacement(
+	before []string,
+	after []string,
+) Replacement {
+	text := strings.Join(after[h.aStart:h.aEnd], "\n")
+	lineEnd := func(i int) int {
+		return utf8.RuneCountInString(before[i]) + 1
+	}
+
+	switch {
+	case h.bStart == h.bEnd && h.bStart < len(before):
+		// Insert the lines before the line.
+		return Replacement{
+			StartLine:   h.bStart + 1,
+			StartColumn: 1,
+			EndLine:     h.bStart + 1,
+			EndColumn:   1,
+			Text:        text + "\n",
+		}
+	case h.bStart == h.bEnd:
+		// Append the lines to the last line.
+		last := len(before) - 1
+		return Replacement{
+			StartLine:   last + 1,
+			StartColumn: lineEnd(last),
+			EndLine:     last + 1,
+			EndColumn:   lineEnd(last),
+			Text:        "\n" + text,
+		}
+	case h.aStart == h.aEnd && h.bEnd < len(before):
+		// Delete the lines including the last line break.
+		return Replacement{
+			StartLine:   h.bStart + 1,
+			StartColumn: 1,
+			EndLine:     h.bEnd + 1,
+			EndColumn:   1,
+		}
+	case h.aStart == h.aEnd && 0 < h.bStart:
+		// Delete the trailing lines including the preceding line break.
+		return Replacement{
+			StartLine:   h.bStart,
+			StartColumn: lineEnd(h.bStart - 1),
+			EndLine:     h.bEnd,
+			EndColumn:   lineEnd(h.bEnd - 1),
+		}
+	}
+	return Replacement{
+		StartLine:   h.bStart + 1,
+		StartColumn: 1,
+		EndLine:     h.bEnd,
+		EndColumn:   lineEnd(h.bEnd - 1),
+		Text:        text,
+	}
+}
+
+// diffLines finds the hunks based on the longest common lines.
+func diffLines(
+	before []string,
+	after []string,
+) []hunk {
+	prefix := 0
+	for prefix < len(before) && prefix < len(after) && before[prefix] == after[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(before)-prefix && suffix < len(after)-prefix &&
+		before[len(before)-1-suffix] == after[len(after)-1-suffix] {
+		suffix++
+	}
+	bs := before[prefix : len(before)-suffix]
+	as := after[prefix : len(after)-suffix]
+	if len(bs) == 0 && len(as) == 0 {
+		return nil
+	}
+	if maxDiffCells < (len(bs)+1)*(len(as)+1) {
+		return []hunk{{prefix, prefix + len(bs), prefix, prefix + len(as)}}
+	}
+
+	// lcs[i][j] is the length of the longest common lines of bs[i:] and as[j:].
+	lcs := make([][]int, len(bs)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(as)+1)
+	}
+	for i := len(bs) - 1; 0 <= i; i-- {
+		for j := len(as) - 1; 0 <= j; j-- {
+			if bs[i] == as[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var hunks []hunk
+	var current *hunk
+	flush := func() {
+		if current != nil {
+			hunks = append(hunks, *current)
+			current = nil
+		}
+	}
+	extend := func(i, j int) {
+		if current == nil {
+			current = &hunk{prefix + i, prefix + i, prefix + j, prefix + j}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(bs) || j < len(as) {
+		switch {
+		case i < len(bs) && j < len(as) && bs[i] == as[j]:
+			flush()
+			i++
+			j++
+		case j == len(as) || (i < len(bs) && lcs[i+1][j] >= lcs[i][j+1]):
+			extend(i, j)
+			i++
+			current.bEnd = prefix + i
+		default:
+			extend(i, j)
+			j++
+			current.aEnd = prefix + j
+		}
+	}
+	flush()
+	return hunks
+}
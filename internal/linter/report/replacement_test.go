@@ -0,0 +1,106 @@
+package report_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/linter/report"
+)
+
+func TestNewReplacements(t *testing.T) {
+	for _, test := range []struct {
+		name             string
+		inputBefore      string
+		inputAfter       string
+		wantReplacements []report.Replacement
+	}{
+		{
+			name:        "no changes",
+			inputBefore: "a\nb\n",
+			inputAfter:  "a\nb\n",
+		},
+		{
+			name:        "change a line",
+			inputBefore: "a\nb\nc\n",
+			inputAfter:  "a\nB\nc\n",
+			wantReplacements: []report.Replacement{
+				{StartLine: 2, StartColumn: 1, EndLine: 2, EndColumn: 2, Text: "B"},
+			},
+		},
+		{
+			name:        "insert lines",
+			inputBefore: "a\nc\n",
+			inputAfter:  "a\nb1\nb2\nc\n",
+			wantReplacements: []report.Replacement{
+				{StartLine: 2, StartColumn: 1, EndLine: 2, EndColumn: 1, Text: "b1\nb2\n"},
+			},
+		},
+		{
+			name:        "append lines",
+			inputBefore: "a\nb",
+			inputAfter:  "a\nb\nc",
+			wantReplacements: []report.Replacement{
+				{StartLine: 2, StartColumn: 2, EndLine: 2, EndColumn: 2, Text: "\nc"},
+			},
+		},
+		{
+			name:        "delete lines",
+			inputBefore: "a\nb\nc\n",
+			inputAfter:  "a\nc\n",
+			wantReplacements: []report.Replacement{
+				{StartLine: 2, StartColumn: 1, EndLine: 3, EndColumn: 1},
+			},
+		},
+		{
+			name:        "delete trailing lines",
+			inputBefore: "a\nb\nc",
+			inputAfter:  "a",
+			wantReplacements: []report.Replacement{
+				{StartLine: 1, StartColumn: 2, EndLine: 3, EndColumn: 2},
+			},
+		},
+		{
+			name:        "swap lines",
+			inputBefore: "import \"b\";\nimport \"a\";\n",
+			inputAfter:  "import \"a\";\nimport \"b\";\n",
+			wantReplacements: []report.Replacement{
+				{StartLine: 1, StartColumn: 1, EndLine: 2, EndColumn: 1},
+				{StartLine: 3, StartColumn: 1, EndLine: 3, EndColumn: 1, Text: "import \"b\";\n"},
+			},
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := report.NewReplacements(test.inputBefore, test.inputAfter)
+			if !reflect.DeepEqual(got, test.wantReplacements) {
+				t.Errorf("got %v, but want %v", got, test.wantReplacements)
+			}
+			if applied := applyReplacements(test.inputBefore, got); applied != test.inputAfter {
+				t.Errorf("got %q after applying, but want %q", applied, test.inputAfter)
+			}
+		})
+	}
+}
+
+func applyReplacements(content string, replacements []report.Replacement) string {
+	lines := strings.SplitAfter(content, "\n")
+	offset := func(line, column int) int {
+		o := 0
+		for _, l := range lines[:line-1] {
+			o += len(l)
+		}
+		return o + len(string([]rune(lines[line-1])[:column-1]))
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, r := range replacements {
+		start := offset(r.StartLine, r.StartColumn)
+		b.WriteString(content[last:start])
+		b.WriteString(r.Text)
+		last = offset(r.EndLine, r.EndColumn)
+	}
+	b.WriteString(content[last:])
+	return b.String()
+}
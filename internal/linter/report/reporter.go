@@ -2,8 +2,8 @@ package report
 
 import (
 	"io"
-	"os"
 
+	"github.com/maramkhaledn/protolint/internal/osutil"
 	"github.com/maramkhaledn/protolint/linter/report"
 )
 
@@ -24,15 +24,38 @@ type ReporterWithOutput struct {
 type ReportersWithOutput []ReporterWithOutput
 
 func (ro ReporterWithOutput) ReportWithFallback(w io.Writer, failures []report.Failure) error {
-	if ro.targetFile != WriteToConsole {
-		var err error
-		w, err = os.OpenFile(ro.targetFile, os.O_WRONLY|os.O_CREATE, 0666)
-		if err != nil {
-			return err
-		}
+	return ro.output(w, func(w io.Writer) error {
+		return ro.reporter.Report(w, failures)
+	})
+}
+
+// output calls write with w, or with the target file if it's set.
+// The target file is replaced only if write succeeds, so that it never has a partial or stale report.
+func (ro ReporterWithOutput) output(w io.Writer, write func(io.Writer) error) error {
+	if ro.targetFile == WriteToConsole {
+		return write(w)
 	}
 
-	return ro.reporter.Report(w, failures)
+	f, err := osutil.CreateAtomicFile(ro.targetFile)
+	if err != nil {
+		return err
+	}
+	if err := write(f); err != nil {
+		_ = f.Discard()
+		return err
+	}
+	return f.Commit()
+}
+
+// ReportWithRunInfo passes the run information too if the reporter is a RunInfoReporter.
+func (ro ReporterWithOutput) ReportWithRunInfo(w io.Writer, failures []report.Failure, info RunInfo) error {
+	r, ok := ro.reporter.(RunInfoReporter)
+	if !ok {
+		return ro.ReportWithFallback(w, failures)
+	}
+	return ro.output(w, func(w io.Writer) error {
+		return r.ReportWithRunInfo(w, failures, info)
+	})
 }
 
 func (ros ReportersWithOutput) ReportWithFallback(w io.Writer, failures []report.Failure) error {
@@ -49,3 +72,38 @@ func (ros ReportersWithOutput) ReportWithFallback(w io.Writer, failures []report
 func NewReporterWithOutput(r Reporter, targetFile string) *ReporterWithOutput {
 	return &ReporterWithOutput{r, targetFile}
 }
+
+// NeedsRunInfo reports whether any reporter is a RunInfoReporter.
+func (ros ReportersWithOutput) NeedsRunInfo() bool {
+	for _, ro := range ros {
+		if _, ok := ro.reporter.(RunInfoReporter); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// NeedsFullRunInfo reports whether any reporter uses the suppressed failures or the fixes.
+func (ros ReportersWithOutput) NeedsFullRunInfo() bool {
+	for _, ro := range ros {
+		if _, ok := ro.reporter.(RunInfoReporter); !ok {
+			continue
+		}
+		if r, ok := ro.reporter.(RulesOnlyReporter); !ok || !r.UsesOnlyRules() {
+			return true
+		}
+	}
+	return false
+}
+
+// ReportWithRunInfo reports failures with the run information.
+func (ros ReportersWithOutput) ReportWithRunInfo(w io.Writer, failures []report.Failure, info RunInfo) error {
+	for _, ro := range ros {
+		err := ro.ReportWithRunInfo(w, failures, info)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package report_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/linter/report"
+	linterreport "github.com/maramkhaledn/protolint/linter/report"
+)
+
+type writeReporter struct {
+	output string
+	err    error
+}
+
+func (r writeReporter) Report(w io.Writer, _ []linterreport.Failure) error {
+	if _, err := io.WriteString(w, r.output); err != nil {
+		return err
+	}
+	return r.err
+}
+
+func TestReporterWithOutput_ReportWithFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputOld     string
+		inputOutput  string
+		inputErr     error
+		wantContent  string
+		wantExistErr bool
+	}{
+		{
+			name:        "Truncates the longer report of the previous run",
+			inputOld:    "the longer report of the previous run",
+			inputOutput: "report",
+			wantContent: "report",
+		},
+		{
+			name:         "Keeps the previous report when the reporter fails",
+			inputOld:     "previous",
+			inputOutput:  "partial",
+			inputErr:     fmt.Errorf("failed"),
+			wantContent:  "previous",
+			wantExistErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			target := filepath.Join(t.TempDir(), "out", "report.txt")
+			if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			if err := os.WriteFile(target, []byte(test.inputOld), 0644); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			r := report.NewReporterWithOutput(writeReporter{output: test.inputOutput, err: test.inputErr}, target)
+			console := &bytes.Buffer{}
+			err := r.ReportWithFallback(console, nil)
+			if test.wantExistErr != (err != nil) {
+				t.Errorf("got err %v, but want err %v", err, test.wantExistErr)
+			}
+
+			got, err := os.ReadFile(target)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			if string(got) != test.wantContent {
+				t.Errorf("got %q, but want %q", got, test.wantContent)
+			}
+			if console.Len() != 0 {
+				t.Errorf("got %q on the console, but want nothing", console)
+			}
+		})
+	}
+}
+
+func TestReporterWithOutput_ReportWithFallback_createsDirectories(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "reports", "nested", "report.txt")
+	r := report.NewReporterWithOutput(writeReporter{output: "report"}, target)
+	if err := r.ReportWithFallback(&bytes.Buffer{}, nil); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	got, err := os.ReadFile(target)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if string(got) != "report" {
+		t.Errorf("got %q, but want %q", got, "report")
+	}
+}
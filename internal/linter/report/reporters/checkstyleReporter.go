@@ -0,0 +1,88 @@
+package reporters
+
+import (
+	"encoding/xml"
+	"io"
+
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+const checkstyleVersion = "8.0"
+
+// CheckstyleReporter prints failures in Checkstyle XML format,
+// consumed by tools like Jenkins warnings-ng, reviewdog and Danger.
+//
+// The failures are grouped by the file in order of appearance.
+type CheckstyleReporter struct{}
+
+type checkstyleOutput struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []checkstyleFile `xml:"file"`
+}
+
+type checkstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []checkstyleError `xml:"error"`
+}
+
+type checkstyleError struct {
+	Line     int    `xml:"line,attr"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+// Report writes failures to w.
+func (r CheckstyleReporter) Report(w io.Writer, fs []report.Failure) error {
+	out := checkstyleOutput{
+		Version: checkstyleVersion,
+	}
+	fileIndexes := make(map[string]int)
+	for _, f := range fs {
+		name := f.Pos().Filename
+		i, ok := fileIndexes[name]
+		if !ok {
+			i = len(out.Files)
+			fileIndexes[name] = i
+			out.Files = append(out.Files, checkstyleFile{Name: name})
+		}
+		out.Files[i].Errors = append(out.Files[i].Errors, checkstyleError{
+			Line:     f.Pos().Line,
+			Column:   f.Pos().Column,
+			Severity: getCheckstyleSeverity(f.Severity()),
+			Message:  f.Message(),
+			Source:   constructTestCaseName(f.RuleID()),
+		})
+	}
+
+	_, err := w.Write([]byte(xml.Header))
+	if err != nil {
+		return err
+	}
+
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	err = enc.Encode(out)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write([]byte("\n"))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getCheckstyleSeverity(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return "warning"
+	case rule.SeverityNote:
+		return "info"
+	}
+	return "error"
+}
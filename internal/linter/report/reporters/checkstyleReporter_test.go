@@ -0,0 +1,91 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestCheckstyleReporter_Report(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name: "Prints no failures in Checkstyle format",
+			wantOutput: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="8.0"></checkstyle>
+`,
+		},
+		{
+			name: "Prints failures grouped by the file in Checkstyle format",
+			inputFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "other.proto",
+						Offset:   50,
+						Line:     3,
+						Column:   1,
+					},
+					"IMPORTS_SORTED",
+					string(rule.SeverityNote),
+					`Imports are not sorted.`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityWarning),
+					`EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantOutput: `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="8.0">
+  <file name="example.proto">
+    <error line="5" column="10" severity="error" message="EnumField name &#34;fIRST_VALUE&#34; must be CAPITALS_WITH_UNDERSCORES" source="net.protolint.ENUM_NAMES_UPPER_CAMEL_CASE"></error>
+    <error line="10" column="20" severity="warning" message="EnumField name &#34;SECOND.VALUE&#34; must be CAPITALS_WITH_UNDERSCORES" source="net.protolint.ENUM_NAMES_UPPER_CAMEL_CASE"></error>
+  </file>
+  <file name="other.proto">
+    <error line="3" column="1" severity="info" message="Imports are not sorted." source="net.protolint.IMPORTS_SORTED"></error>
+  </file>
+</checkstyle>
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.CheckstyleReporter{}.Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
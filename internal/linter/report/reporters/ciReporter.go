@@ -131,9 +131,7 @@ func (c CiReporter) getTemplateString() CiPipelineLogTemplate {
 func (c CiReporter) getTemplate() (*template.Template, error) {
 	toParse := c.getTemplateString()
 
-	toUpper := template.FuncMap{"ToUpper": strings.ToUpper}
-
-	template := template.New("Failure").Funcs(toUpper)
+	template := template.New("Failure").Funcs(templateFuncs)
 	evaluate, err := template.Parse(string(toParse))
 	if err != nil {
 		return nil, err
@@ -0,0 +1,43 @@
+package reporters
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"github.com/maramkhaledn/protolint/linter/report"
+)
+
+// quotedName matches the first quoted name in a failure message, like "FieldB" in
+// `Field name "FieldB" must be underscore_separated_names`.
+var quotedName = regexp.MustCompile(`"([^"]*[^"\s][^"]*)"`)
+
+// elementName returns the name of the element the failure is about.
+// It falls back to the whole message when the message quotes no name.
+func elementName(f report.Failure) string {
+	if m := quotedName.FindStringSubmatch(f.Message()); m != nil {
+		return m[1]
+	}
+	return f.Message()
+}
+
+// fingerprints returns the fingerprints of the failures derived from the rule ID, the path and the element name.
+// They don't include the positions so that they stay the same while the lines around the element change.
+// The failures with the same key are told apart by the order of appearance.
+func fingerprints(fs []report.Failure) []string {
+	seen := make(map[string]int)
+	var prints []string
+	for _, f := range fs {
+		base := fmt.Sprintf("%s\x00%s\x00%s", f.RuleID(), f.Pos().Filename, elementName(f))
+		key := base
+		if n := seen[base]; 0 < n {
+			key = fmt.Sprintf("%s\x00%d", base, n)
+		}
+		seen[base]++
+
+		sum := sha256.Sum256([]byte(key))
+		prints = append(prints, hex.EncodeToString(sum[:]))
+	}
+	return prints
+}
@@ -0,0 +1,87 @@
+package reporters
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// GitlabReporter prints failures in GitLab Code Quality format.
+// Refer to https://docs.gitlab.com/ee/ci/testing/code_quality.html#code-quality-report-format
+// for details to the format.
+//
+// The fingerprints are derived from the rule ID, the path and the element name,
+// so that the merge request widget keeps tracking a failure while the lines around it change.
+type GitlabReporter struct{}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end,omitempty"`
+}
+
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitlabLocation `json:"location"`
+}
+
+// Report writes failures to w.
+func (r GitlabReporter) Report(w io.Writer, fs []report.Failure) error {
+	// GitLab requires an array even if there are no issues.
+	issues := []gitlabIssue{}
+	for i, fingerprint := range fingerprints(fs) {
+		f := fs[i]
+		lines := gitlabLines{
+			Begin: f.Pos().Line,
+		}
+		if f.HasRange() {
+			lines.End = f.End().Line
+		}
+
+		issues = append(issues, gitlabIssue{
+			Description: f.Message(),
+			CheckName:   f.RuleID(),
+			Fingerprint: fingerprint,
+			Severity:    getGitlabSeverity(f.Severity()),
+			Location: gitlabLocation{
+				Path:  f.Pos().Filename,
+				Lines: lines,
+			},
+		})
+	}
+
+	bs, err := json.MarshalIndent(issues, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(bs)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write([]byte("\n"))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func getGitlabSeverity(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return "minor"
+	case rule.SeverityNote:
+		return "info"
+	}
+	return "major"
+}
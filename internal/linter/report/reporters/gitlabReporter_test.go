@@ -0,0 +1,188 @@
+package reporters_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestGitlabReporter_Report(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name: "Prints no failures as an empty array",
+			wantOutput: `[]
+`,
+		},
+		{
+			name: "Prints failures in GitLab Code Quality format",
+			inputFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   200,
+						Line:     10,
+						Column:   20,
+					},
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   300,
+						Line:     12,
+						Column:   1,
+					},
+					"MESSAGE_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityWarning),
+					`Message name "foo_bar" must be UpperCamelCase like "FooBar"`,
+				),
+			},
+			wantOutput: `[
+  {
+    "description": "EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES",
+    "check_name": "ENUM_NAMES_UPPER_CAMEL_CASE",
+    "fingerprint": "61cbbe4361df945274350da5e92beb091d0a59a940177e23f7608fda38c0b776",
+    "severity": "major",
+    "location": {
+      "path": "example.proto",
+      "lines": {
+        "begin": 5
+      }
+    }
+  },
+  {
+    "description": "Message name \"foo_bar\" must be UpperCamelCase like \"FooBar\"",
+    "check_name": "MESSAGE_NAMES_UPPER_CAMEL_CASE",
+    "fingerprint": "7bfdcf49433765eb3271c1e3472146a8013e61852933384d25ebcab9b5b006af",
+    "severity": "minor",
+    "location": {
+      "path": "example.proto",
+      "lines": {
+        "begin": 10,
+        "end": 12
+      }
+    }
+  }
+]
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.GitlabReporter{}.Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
+
+func TestGitlabReporter_Report_fingerprints(t *testing.T) {
+	failureAt := func(filename string, line int, ruleID string, message string) report.Failure {
+		return report.Failuref(
+			meta.Position{
+				Filename: filename,
+				Line:     line,
+				Column:   1,
+			},
+			ruleID,
+			string(rule.SeverityError),
+			"%s",
+			message,
+		)
+	}
+	fieldMessage := `Field name "FieldA" must be underscore_separated_names`
+
+	tests := []struct {
+		name           string
+		inputFailures  []report.Failure
+		inputOthers    []report.Failure
+		wantSamePrints bool
+	}{
+		{
+			name:           "Keeps the fingerprint when the line moves",
+			inputFailures:  []report.Failure{failureAt("a.proto", 5, "FIELD_NAMES_LOWER_SNAKE_CASE", fieldMessage)},
+			inputOthers:    []report.Failure{failureAt("a.proto", 9, "FIELD_NAMES_LOWER_SNAKE_CASE", fieldMessage)},
+			wantSamePrints: true,
+		},
+		{
+			name:          "Changes the fingerprint with the element name",
+			inputFailures: []report.Failure{failureAt("a.proto", 5, "FIELD_NAMES_LOWER_SNAKE_CASE", fieldMessage)},
+			inputOthers:   []report.Failure{failureAt("a.proto", 5, "FIELD_NAMES_LOWER_SNAKE_CASE", `Field name "FieldB" must be underscore_separated_names`)},
+		},
+		{
+			name:          "Changes the fingerprint with the path",
+			inputFailures: []report.Failure{failureAt("a.proto", 5, "FIELD_NAMES_LOWER_SNAKE_CASE", fieldMessage)},
+			inputOthers:   []report.Failure{failureAt("b.proto", 5, "FIELD_NAMES_LOWER_SNAKE_CASE", fieldMessage)},
+		},
+		{
+			name:          "Changes the fingerprint with the rule",
+			inputFailures: []report.Failure{failureAt("a.proto", 5, "FIELD_NAMES_LOWER_SNAKE_CASE", fieldMessage)},
+			inputOthers:   []report.Failure{failureAt("a.proto", 5, "FIELD_NAMES_EXCLUDE_PREPOSITIONS", fieldMessage)},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got := gitlabFingerprints(t, test.inputFailures)
+			others := gitlabFingerprints(t, test.inputOthers)
+			if (got[0] == others[0]) != test.wantSamePrints {
+				t.Errorf("got %v and %v, but want the same ones %v", got, others, test.wantSamePrints)
+			}
+		})
+	}
+
+	t.Run("Tells apart the failures of the same element", func(t *testing.T) {
+		got := gitlabFingerprints(t, []report.Failure{
+			failureAt("a.proto", 5, "INDENT", `Found an incorrect indentation style "  ". "    " is correct.`),
+			failureAt("a.proto", 6, "INDENT", `Found an incorrect indentation style "  ". "    " is correct.`),
+		})
+		if got[0] == got[1] {
+			t.Errorf("got the same fingerprints %v", got)
+		}
+	})
+}
+
+func gitlabFingerprints(t *testing.T, fs []report.Failure) []string {
+	buf := &bytes.Buffer{}
+	if err := (reporters.GitlabReporter{}).Report(buf, fs); err != nil {
+		t.Fatalf("got err %v", err)
+	}
+	var issues []struct {
+		Fingerprint string `json:"fingerprint"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &issues); err != nil {
+		t.Fatalf("got err %v", err)
+	}
+	var prints []string
+	for _, issue := range issues {
+		prints = append(prints, issue.Fingerprint)
+	}
+	return prints
+}
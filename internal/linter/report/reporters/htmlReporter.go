@@ -0,0 +1,228 @@
+package reporters
+
+import (
+	"html/template"
+	"io"
+	"path/filepath"
+	"sort"
+
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// htmlSnippetContext is the number of lines printed before and after the failure position.
+const htmlSnippetContext = 2
+
+// HTMLReporter prints failures as a single static HTML document.
+//
+// The document includes the totals by severity, by rule and by directory,
+// a sortable table of the failures and the source snippets around them.
+// It embeds all styles and scripts so that it works offline.
+type HTMLReporter struct{}
+
+type keyedCount struct {
+	Key   string
+	Count int
+}
+
+type htmlSnippetLine struct {
+	Number  int
+	Text    string
+	Current bool
+}
+
+type htmlFailure struct {
+	File     string
+	Line     int
+	Column   int
+	Severity string
+	Rule     string
+	Message  string
+	Snippet  []htmlSnippetLine
+}
+
+type htmlReport struct {
+	Total       int
+	Files       int
+	BySeverity  []keyedCount
+	ByRule      []keyedCount
+	ByDirectory []keyedCount
+	Failures    []htmlFailure
+}
+
+// Report writes failures to w.
+func (r HTMLReporter) Report(w io.Writer, fs []report.Failure) error {
+	sources := make(sourceLines)
+	bySeverity := make(map[string]int)
+	byRule := make(map[string]int)
+	byDirectory := make(map[string]int)
+	files := make(map[string]bool)
+
+	out := htmlReport{
+		Total: len(fs),
+	}
+	for _, f := range fs {
+		bySeverity[f.Severity()]++
+		byRule[f.RuleID()]++
+		byDirectory[filepath.Dir(f.Pos().Filename)]++
+		files[f.Pos().Filename] = true
+
+		out.Failures = append(out.Failures, htmlFailure{
+			File:     f.Pos().Filename,
+			Line:     f.Pos().Line,
+			Column:   f.Pos().Column,
+			Severity: f.Severity(),
+			Rule:     f.RuleID(),
+			Message:  f.Message(),
+			Snippet:  htmlSnippet(sources, f),
+		})
+	}
+	out.Files = len(files)
+
+	for _, s := range []rule.Severity{rule.SeverityError, rule.SeverityWarning, rule.SeverityNote} {
+		out.BySeverity = append(out.BySeverity, keyedCount{Key: string(s), Count: bySeverity[string(s)]})
+	}
+	out.ByRule = sortedCounts(byRule)
+	out.ByDirectory = sortedCounts(byDirectory)
+
+	return htmlTemplate.Execute(w, out)
+}
+
+func htmlSnippet(
+	sources sourceLines,
+	f report.Failure,
+) []htmlSnippetLine {
+	var snippet []htmlSnippetLine
+	for n := f.Pos().Line - htmlSnippetContext; n <= f.Pos().Line+htmlSnippetContext; n++ {
+		text, ok := sources.line(f.Pos().Filename, n)
+		if !ok {
+			continue
+		}
+		snippet = append(snippet, htmlSnippetLine{
+			Number:  n,
+			Text:    text,
+			Current: n == f.Pos().Line,
+		})
+	}
+	return snippet
+}
+
+// sortedCounts sorts the counts in descending order, and then by the key.
+func sortedCounts(counts map[string]int) []keyedCount {
+	var sorted []keyedCount
+	for k, c := range counts {
+		sorted = append(sorted, keyedCount{Key: k, Count: c})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Key < sorted[j].Key
+	})
+	return sorted
+}
+
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>protolint report</title>
+<style>
+body { font-family: -apple-system, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292f; }
+h1, h2 { font-weight: 600; }
+.summary { display: flex; flex-wrap: wrap; gap: 2em; }
+.summary table { min-width: 16em; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #d0d7de; padding: 4px 8px; text-align: left; vertical-align: top; }
+th { background: #f6f8fa; }
+#failures th { cursor: pointer; user-select: none; }
+#failures th[data-order="asc"]::after { content: " \25B2"; }
+#failures th[data-order="desc"]::after { content: " \25BC"; }
+.num { text-align: right; }
+.severity-error { color: #cf222e; font-weight: 600; }
+.severity-warning { color: #9a6700; font-weight: 600; }
+.severity-note { color: #0969da; }
+pre.snippet { margin: 4px 0 0; padding: 4px; background: #f6f8fa; font-size: 12px; }
+pre.snippet .current { background: #ffebe9; display: block; }
+</style>
+</head>
+<body>
+<h1>protolint report</h1>
+<p>{{.Total}} failure(s) in {{.Files}} file(s).</p>
+<div class="summary">
+<table>
+<tr><th>Severity</th><th>Failures</th></tr>
+{{- range .BySeverity}}
+<tr><td class="severity-{{.Key}}">{{.Key}}</td><td class="num">{{.Count}}</td></tr>
+{{- end}}
+</table>
+<table>
+<tr><th>Rule</th><th>Failures</th></tr>
+{{- range .ByRule}}
+<tr><td>{{.Key}}</td><td class="num">{{.Count}}</td></tr>
+{{- end}}
+</table>
+<table>
+<tr><th>Directory</th><th>Failures</th></tr>
+{{- range .ByDirectory}}
+<tr><td>{{.Key}}</td><td class="num">{{.Count}}</td></tr>
+{{- end}}
+</table>
+</div>
+<h2>Failures</h2>
+<table id="failures">
+<thead>
+<tr><th data-type="text">File</th><th data-type="number">Line</th><th data-type="number">Column</th><th data-type="text">Severity</th><th data-type="text">Rule</th><th data-type="text">Message</th></tr>
+</thead>
+<tbody>
+{{- range .Failures}}
+<tr>
+<td>{{.File}}</td>
+<td class="num">{{.Line}}</td>
+<td class="num">{{.Column}}</td>
+<td class="severity-{{.Severity}}">{{.Severity}}</td>
+<td>{{.Rule}}</td>
+<td>{{.Message}}
+{{- if .Snippet}}
+<pre class="snippet">
+{{- range .Snippet}}<span{{if .Current}} class="current"{{end}}>{{printf "%5d" .Number}} | {{.Text}}</span>
+{{end -}}
+</pre>
+{{- end}}
+</td>
+</tr>
+{{- end}}
+</tbody>
+</table>
+<script>
+(function () {
+  var table = document.getElementById("failures");
+  var headers = table.tHead.rows[0].cells;
+  for (var i = 0; i < headers.length; i++) {
+    headers[i].addEventListener("click", sortBy(i));
+  }
+  function sortBy(index) {
+    return function () {
+      var header = headers[index];
+      var asc = header.getAttribute("data-order") !== "asc";
+      for (var i = 0; i < headers.length; i++) {
+        headers[i].removeAttribute("data-order");
+      }
+      header.setAttribute("data-order", asc ? "asc" : "desc");
+      var numeric = header.getAttribute("data-type") === "number";
+      var body = table.tBodies[0];
+      var rows = Array.prototype.slice.call(body.rows);
+      rows.sort(function (a, b) {
+        var x = a.cells[index].firstChild ? a.cells[index].firstChild.textContent.trim() : "";
+        var y = b.cells[index].firstChild ? b.cells[index].firstChild.textContent.trim() : "";
+        var c = numeric ? Number(x) - Number(y) : x.localeCompare(y);
+        return asc ? c : -c;
+      });
+      rows.forEach(function (row) { body.appendChild(row); });
+    };
+  }
+})();
+</script>
+</body>
+</html>
+`))
@@ -0,0 +1,130 @@
+package reporters_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestHTMLReporter_Report(t *testing.T) {
+	dir := t.TempDir()
+	protoPath := filepath.Join(dir, "example.proto")
+	err := os.WriteFile(protoPath, []byte(`syntax = "proto3";
+
+enum Enum {
+  fIRST_VALUE = 0;
+  SECOND_VALUE = 1;
+}
+`), 0644)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		wantContains  []string
+	}{
+		{
+			name: "Prints no failures",
+			wantContains: []string{
+				"<p>0 failure(s) in 0 file(s).</p>",
+				`<tr><td class="severity-error">error</td><td class="num">0</td></tr>`,
+			},
+		},
+		{
+			name: "Prints the totals, the failures and the source snippets",
+			inputFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: protoPath,
+						Line:     4,
+						Column:   3,
+					},
+					"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: protoPath,
+						Line:     1,
+						Column:   1,
+					},
+					"FILE_HAS_COMMENT",
+					string(rule.SeverityNote),
+					`File should have a comment`,
+				),
+				report.Failuref(
+					meta.Position{
+						Filename: "not/found.proto",
+						Line:     10,
+						Column:   20,
+					},
+					"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+					string(rule.SeverityWarning),
+					`EnumField name "second" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantContains: []string{
+				"<p>3 failure(s) in 2 file(s).</p>",
+				`<tr><td class="severity-error">error</td><td class="num">1</td></tr>`,
+				`<tr><td class="severity-warning">warning</td><td class="num">1</td></tr>`,
+				`<tr><td class="severity-note">note</td><td class="num">1</td></tr>`,
+				`<tr><td>ENUM_FIELD_NAMES_UPPER_SNAKE_CASE</td><td class="num">2</td></tr>
+<tr><td>FILE_HAS_COMMENT</td><td class="num">1</td></tr>`,
+				`<tr><td>` + dir + `</td><td class="num">2</td></tr>
+<tr><td>not</td><td class="num">1</td></tr>`,
+				`<td>EnumField name &#34;fIRST_VALUE&#34; must be CAPITALS_WITH_UNDERSCORES
+<pre class="snippet"><span>    2 | </span>
+<span>    3 | enum Enum {</span>
+<span class="current">    4 |   fIRST_VALUE = 0;</span>
+<span>    5 |   SECOND_VALUE = 1;</span>
+<span>    6 | }</span>
+</pre>
+</td>`,
+				`<td>File should have a comment
+<pre class="snippet"><span class="current">    1 | syntax = &#34;proto3&#34;;</span>
+<span>    2 | </span>
+<span>    3 | enum Enum {</span>
+</pre>
+</td>`,
+				`<td>EnumField name &#34;second&#34; must be CAPITALS_WITH_UNDERSCORES
+</td>`,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.HTMLReporter{}.Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			got := buf.String()
+			for _, want := range test.wantContains {
+				if !strings.Contains(got, want) {
+					t.Errorf("got %s, but want to contain %s", got, want)
+				}
+			}
+			// The report must work offline.
+			for _, external := range []string{"<link", "<script src", "http://", "https://"} {
+				if strings.Contains(got, external) {
+					t.Errorf("got %s, but want no external asset %s", got, external)
+				}
+			}
+		})
+	}
+}
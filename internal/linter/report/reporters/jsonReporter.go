@@ -17,18 +17,22 @@ import (
 //	 {
 //			"lints":
 //				[
-//					{"filename": FILENAME, "line": LINE, "column": COL, "message": MESSAGE, "rule": RULE}
+//					{"filename": FILENAME, "line": LINE, "column": COL, "end_line": END_LINE, "end_column": END_COL, "message": MESSAGE, "rule": RULE}
 //				],
 //	 }
+//
+// end_line and end_column are omitted when the failure has no range.
 type JSONReporter struct{}
 
 type lintJSON struct {
-	Filename string `json:"filename"`
-	Line     int    `json:"line"`
-	Column   int    `json:"column"`
-	Message  string `json:"message"`
-	Rule     string `json:"rule"`
-	Severity string `json:"severity"`
+	Filename  string `json:"filename"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndLine   int    `json:"end_line,omitempty"`
+	EndColumn int    `json:"end_column,omitempty"`
+	Message   string `json:"message"`
+	Rule      string `json:"rule"`
+	Severity  string `json:"severity"`
 }
 
 type outJSON struct {
@@ -48,12 +52,14 @@ func (r JSONReporter) Report(w io.Writer, fs []report.Failure) error {
 	// Write failures
 	for _, failure := range fs {
 		out.Lints = append(out.Lints, lintJSON{
-			Filename: failure.Pos().Filename,
-			Line:     failure.Pos().Line,
-			Column:   failure.Pos().Column,
-			Message:  failure.Message(),
-			Rule:     failure.RuleID(),
-			Severity: failure.Severity(),
+			Filename:  failure.Pos().Filename,
+			Line:      failure.Pos().Line,
+			Column:    failure.Pos().Column,
+			EndLine:   failure.End().Line,
+			EndColumn: failure.End().Column,
+			Message:   failure.Message(),
+			Rule:      failure.RuleID(),
+			Severity:  failure.Severity(),
 		})
 	}
 
@@ -66,6 +66,46 @@ func TestJSONReporter_Report(t *testing.T) {
     }
   ]
 }
+`
+			},
+		},
+		{
+			name: "Prints failures with the ranges",
+			inputFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   125,
+						Line:     5,
+						Column:   35,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantOutput: func(basedir string) string {
+				return `{
+  "basedir": "` + basedir + `",
+  "lints": [
+    {
+      "filename": "example.proto",
+      "line": 5,
+      "column": 10,
+      "end_line": 5,
+      "end_column": 35,
+      "message": "EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES",
+      "rule": "ENUM_NAMES_UPPER_CAMEL_CASE",
+      "severity": "error"
+    }
+  ]
+}
 `
 			},
 		},
@@ -0,0 +1,118 @@
+package reporters
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/maramkhaledn/protolint/linter/report"
+)
+
+// DefaultMarkdownMaxSize fits the report in a GitHub comment, which is up to 65536 characters.
+const DefaultMarkdownMaxSize = 65000
+
+// markdownNoteReserve is the size reserved for the note of the omitted failures.
+const markdownNoteReserve = 64
+
+// MarkdownReporter prints failures as Markdown for pull request comments.
+//
+// It groups the failures per file into collapsible sections with tables,
+// and prints a collapsed message when there are no failures.
+// It omits the failures beyond maxSize bytes with a note of the number of them.
+type MarkdownReporter struct {
+	maxSize int
+}
+
+// NewMarkdownReporter creates a MarkdownReporter which prints up to maxSize bytes.
+func NewMarkdownReporter(maxSize int) MarkdownReporter {
+	return MarkdownReporter{
+		maxSize: maxSize,
+	}
+}
+
+// Report writes failures to w.
+func (r MarkdownReporter) Report(w io.Writer, fs []report.Failure) error {
+	_, err := io.WriteString(w, r.markdown(fs))
+	return err
+}
+
+func (r MarkdownReporter) markdown(fs []report.Failure) string {
+	if len(fs) == 0 {
+		return "<details>\n<summary>protolint found no failures</summary>\n\nAll files passed the lint.\n\n</details>\n"
+	}
+
+	var files []string
+	byFile := make(map[string][]report.Failure)
+	for _, f := range fs {
+		name := f.Pos().Filename
+		if _, ok := byFile[name]; !ok {
+			files = append(files, name)
+		}
+		byFile[name] = append(byFile[name], f)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "### protolint found %s in %s\n\n", plural(len(fs), "failure"), plural(len(files), "file"))
+
+	const sectionEnd = "\n</details>\n\n"
+	shown := 0
+	truncated := false
+	for _, name := range files {
+		sectionStart := fmt.Sprintf(
+			"<details>\n<summary><code>%s</code> (%s)</summary>\n\n| Line | Rule | Severity | Message |\n| ---: | --- | --- | --- |\n",
+			escapeMarkdownHTML(name),
+			plural(len(byFile[name]), "failure"),
+		)
+		opened := false
+		for _, f := range byFile[name] {
+			row := fmt.Sprintf(
+				"| %d | `%s` | %s | %s |\n",
+				f.Pos().Line,
+				f.RuleID(),
+				f.Severity(),
+				markdownTableCell(f.Message()),
+			)
+			need := len(row) + len(sectionEnd) + markdownNoteReserve
+			if !opened {
+				need += len(sectionStart)
+			}
+			if 0 < r.maxSize && r.maxSize < b.Len()+need {
+				truncated = true
+				break
+			}
+			if !opened {
+				b.WriteString(sectionStart)
+				opened = true
+			}
+			b.WriteString(row)
+			shown++
+		}
+		if opened {
+			b.WriteString(sectionEnd)
+		}
+		if truncated {
+			break
+		}
+	}
+
+	if truncated {
+		rest := len(fs) - shown
+		note := "**%d more failures are not shown.**\n"
+		if rest == 1 {
+			note = "**%d more failure is not shown.**\n"
+		}
+		fmt.Fprintf(&b, note, rest)
+	}
+	return b.String()
+}
+
+var markdownHTMLEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func escapeMarkdownHTML(s string) string {
+	return markdownHTMLEscaper.Replace(s)
+}
+
+// markdownTableCell escapes s to keep it in a cell of a table.
+func markdownTableCell(s string) string {
+	return strings.ReplaceAll(escapeMarkdown(s), "\n", "<br>")
+}
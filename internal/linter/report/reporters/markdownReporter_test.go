@@ -0,0 +1,117 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestMarkdownReporter_Report(t *testing.T) {
+	failures := []report.Failure{
+		report.Failuref(
+			meta.Position{Filename: "proto/example.proto", Line: 5, Column: 10},
+			"ENUM_NAMES_UPPER_CAMEL_CASE",
+			string(rule.SeverityError),
+			`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+		),
+		report.Failuref(
+			meta.Position{Filename: "proto/other.proto", Line: 1, Column: 1},
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			`File should have a comment`,
+		),
+		report.Failuref(
+			meta.Position{Filename: "proto/example.proto", Line: 10, Column: 20},
+			"MAX_LINE_LENGTH",
+			string(rule.SeverityWarning),
+			`The line length is 130, but it must be shorter than 120 | 80`,
+		),
+	}
+
+	tests := []struct {
+		name          string
+		inputMaxSize  int
+		inputFailures []report.Failure
+		wantOutput    string
+	}{
+		{
+			name:         "Prints a collapsed success message",
+			inputMaxSize: reporters.DefaultMarkdownMaxSize,
+			wantOutput: `<details>
+<summary>protolint found no failures</summary>
+
+All files passed the lint.
+
+</details>
+`,
+		},
+		{
+			name:          "Prints failures grouped per file",
+			inputMaxSize:  reporters.DefaultMarkdownMaxSize,
+			inputFailures: failures,
+			wantOutput: `### protolint found 3 failures in 2 files
+
+<details>
+<summary><code>proto/example.proto</code> (2 failures)</summary>
+
+| Line | Rule | Severity | Message |
+| ---: | --- | --- | --- |
+| 5 | ` + "`ENUM_NAMES_UPPER_CAMEL_CASE`" + ` | error | EnumField name "fIRST\_VALUE" must be CAPITALS\_WITH\_UNDERSCORES |
+| 10 | ` + "`MAX_LINE_LENGTH`" + ` | warning | The line length is 130, but it must be shorter than 120 \| 80 |
+
+</details>
+
+<details>
+<summary><code>proto/other.proto</code> (1 failure)</summary>
+
+| Line | Rule | Severity | Message |
+| ---: | --- | --- | --- |
+| 1 | ` + "`FILE_HAS_COMMENT`" + ` | note | File should have a comment |
+
+</details>
+
+`,
+		},
+		{
+			name:          "Omits the failures beyond the max size",
+			inputMaxSize:  400,
+			inputFailures: failures,
+			wantOutput: `### protolint found 3 failures in 2 files
+
+<details>
+<summary><code>proto/example.proto</code> (2 failures)</summary>
+
+| Line | Rule | Severity | Message |
+| ---: | --- | --- | --- |
+| 5 | ` + "`ENUM_NAMES_UPPER_CAMEL_CASE`" + ` | error | EnumField name "fIRST\_VALUE" must be CAPITALS\_WITH\_UNDERSCORES |
+
+</details>
+
+**2 more failures are not shown.**
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.NewMarkdownReporter(test.inputMaxSize).Report(buf, test.inputFailures)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+			if 0 < test.inputMaxSize && test.inputMaxSize < buf.Len() {
+				t.Errorf("got %d bytes, but want up to %d bytes", buf.Len(), test.inputMaxSize)
+			}
+		})
+	}
+}
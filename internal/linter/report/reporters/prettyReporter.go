@@ -0,0 +1,213 @@
+package reporters
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+const (
+	ansiReset  = "\x1b[0m"
+	ansiBold   = "\x1b[1m"
+	ansiRed    = "\x1b[31m"
+	ansiGreen  = "\x1b[32m"
+	ansiYellow = "\x1b[33m"
+	ansiCyan   = "\x1b[36m"
+	ansiBlue   = "\x1b[34m"
+)
+
+// PrettyReporter prints failures with the source lines like the modern compiler diagnostics.
+//
+// The format is:
+//
+//	error[RULE]: MESSAGE
+//	  --> FILENAME:LINE:COL
+//	   |
+//	LINE | SOURCE
+//	   |   ^^^^^^
+//	   = hint: fixable with protolint -fix
+//
+// It colors the output only when w is a terminal and the NO_COLOR environment variable is not set.
+type PrettyReporter struct{}
+
+// UsesOnlyRules tells that the reporter needs only the rules to print the fixable hints.
+func (r PrettyReporter) UsesOnlyRules() bool {
+	return true
+}
+
+// Report writes failures to w.
+func (r PrettyReporter) Report(w io.Writer, fs []report.Failure) error {
+	return r.report(w, fs, internalreport.RunInfo{})
+}
+
+// ReportWithRunInfo writes failures to w with the fixable hints.
+func (r PrettyReporter) ReportWithRunInfo(w io.Writer, fs []report.Failure, info internalreport.RunInfo) error {
+	return r.report(w, fs, info)
+}
+
+func (r PrettyReporter) report(w io.Writer, fs []report.Failure, info internalreport.RunInfo) error {
+	p := prettyPrinter{
+		w:     w,
+		color: useColor(w),
+	}
+	sources := make(sourceLines)
+	files := make(map[string]bool)
+	counts := make(map[string]int)
+	var fixables int
+
+	for _, f := range fs {
+		files[f.Pos().Filename] = true
+		counts[f.Severity()]++
+		d, _ := info.Rule(f.RuleID())
+		if d.Fixable {
+			fixables++
+		}
+
+		p.failure(sources, f, d.Fixable)
+	}
+	if len(fs) == 0 {
+		return p.err
+	}
+
+	summary := fmt.Sprintf(
+		"%s (%s, %s, %s) in %s",
+		plural(len(fs), "failure"),
+		plural(counts[string(rule.SeverityError)], "error"),
+		plural(counts[string(rule.SeverityWarning)], "warning"),
+		plural(counts[string(rule.SeverityNote)], "note"),
+		plural(len(files), "file"),
+	)
+	if 0 < fixables {
+		summary += fmt.Sprintf(", %d fixable with protolint -fix", fixables)
+	}
+	p.printf("%s\n", p.paint(ansiBold, summary+"."))
+	return p.err
+}
+
+// prettyPrinter keeps the first error to stop writing after it.
+type prettyPrinter struct {
+	w     io.Writer
+	color bool
+	err   error
+}
+
+func (p *prettyPrinter) printf(format string, a ...interface{}) {
+	if p.err != nil {
+		return
+	}
+	_, p.err = fmt.Fprintf(p.w, format, a...)
+}
+
+func (p *prettyPrinter) paint(code string, s string) string {
+	if !p.color {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+func (p *prettyPrinter) failure(
+	sources sourceLines,
+	f report.Failure,
+	fixable bool,
+) {
+	severityColor := prettySeverityColor(f.Severity())
+	p.printf(
+		"%s%s %s\n",
+		p.paint(ansiBold+severityColor, f.Severity()),
+		p.paint(ansiBold, "["+f.RuleID()+"]:"),
+		p.paint(ansiBold, f.Message()),
+	)
+
+	pos := f.Pos()
+	gutter := strings.Repeat(" ", len(strconv.Itoa(pos.Line)))
+	p.printf("%s %s %s:%d:%d\n", gutter, p.paint(ansiBlue, "-->"), pos.Filename, pos.Line, pos.Column)
+
+	if line, ok := sources.line(pos.Filename, pos.Line); ok {
+		bar := p.paint(ansiBlue, "|")
+		p.printf("%s %s\n", gutter, bar)
+		p.printf("%s %s %s\n", p.paint(ansiBlue, strconv.Itoa(pos.Line)), bar, line)
+		p.printf("%s %s %s\n", gutter, bar, p.paint(severityColor, underline(line, f)))
+	}
+	if fixable {
+		p.printf("%s %s %s\n", gutter, p.paint(ansiBlue, "="), p.paint(ansiGreen, "hint: fixable with protolint -fix"))
+	}
+	p.printf("\n")
+}
+
+// underline returns the carets under the range of the failure in the line.
+// It keeps the tabs before the range so that the carets line up with the source.
+// The range is cut at the end of the line if it spans multiple lines.
+func underline(
+	line string,
+	f report.Failure,
+) string {
+	runes := []rune(line)
+	start := f.Pos().Column - 1
+	if start < 0 {
+		start = 0
+	}
+	if len(runes) < start {
+		start = len(runes)
+	}
+	end := start + 1
+	if f.HasRange() {
+		end = len(runes)
+		if f.End().Line == f.Pos().Line {
+			end = f.End().Column
+		}
+	}
+	if end <= start {
+		end = start + 1
+	}
+
+	var b strings.Builder
+	for _, r := range runes[:start] {
+		if r == '\t' {
+			b.WriteRune('\t')
+		} else {
+			b.WriteRune(' ')
+		}
+	}
+	b.WriteString(strings.Repeat("^", end-start))
+	return b.String()
+}
+
+func prettySeverityColor(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return ansiYellow
+	case rule.SeverityNote:
+		return ansiCyan
+	}
+	return ansiRed
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
+
+// useColor reports whether w is a terminal and NO_COLOR is not set.
+// Refer to https://no-color.org/.
+func useColor(w io.Writer) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	f, ok := w.(*os.File)
+	if !ok {
+		return false
+	}
+	info, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return info.Mode()&os.ModeCharDevice != 0
+}
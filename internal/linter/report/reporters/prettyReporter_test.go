@@ -0,0 +1,115 @@
+package reporters_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestPrettyReporter_ReportWithRunInfo(t *testing.T) {
+	protoPath := filepath.Join(t.TempDir(), "example.proto")
+	err := os.WriteFile(protoPath, []byte("syntax = \"proto3\";\n\nenum Enum {\n\tfIRST_VALUE = 0;\n}\n"), 0644)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	pos := func(line, column int) meta.Position {
+		return meta.Position{Filename: protoPath, Line: line, Column: column}
+	}
+
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		inputInfo     internalreport.RunInfo
+		wantOutput    string
+	}{
+		{
+			name:       "Prints nothing without failures",
+			wantOutput: ``,
+		},
+		{
+			name: "Prints the source lines with the carets and the summary",
+			inputFailures: []report.Failure{
+				report.FailureWithRangef(
+					pos(4, 2),
+					pos(4, 17),
+					"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+				report.FailureWithRangef(
+					pos(3, 1),
+					pos(5, 1),
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityWarning),
+					`Enum name "Enum" is reserved`,
+				),
+				report.Failuref(
+					pos(1, 1),
+					"FILE_HAS_COMMENT",
+					string(rule.SeverityNote),
+					`File should have a comment`,
+				),
+				report.Failuref(
+					meta.Position{Filename: "not/found.proto", Line: 10, Column: 20},
+					"FILE_HAS_COMMENT",
+					string(rule.SeverityNote),
+					`File should have a comment`,
+				),
+			},
+			inputInfo: internalreport.RunInfo{
+				Rules: []internalreport.RuleDescriptor{
+					{ID: "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE", Fixable: true},
+					{ID: "FILE_HAS_COMMENT"},
+				},
+			},
+			wantOutput: `error[ENUM_FIELD_NAMES_UPPER_SNAKE_CASE]: EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES
+  --> ` + protoPath + `:4:2
+  |
+4 | 	fIRST_VALUE = 0;
+  | 	^^^^^^^^^^^^^^^^
+  = hint: fixable with protolint -fix
+
+warning[ENUM_NAMES_UPPER_CAMEL_CASE]: Enum name "Enum" is reserved
+  --> ` + protoPath + `:3:1
+  |
+3 | enum Enum {
+  | ^^^^^^^^^^^
+
+note[FILE_HAS_COMMENT]: File should have a comment
+  --> ` + protoPath + `:1:1
+  |
+1 | syntax = "proto3";
+  | ^
+
+note[FILE_HAS_COMMENT]: File should have a comment
+   --> not/found.proto:10:20
+
+4 failures (1 error, 1 warning, 2 notes) in 2 files, 1 fixable with protolint -fix.
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.PrettyReporter{}.ReportWithRunInfo(buf, test.inputFailures, test.inputInfo)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package reporters
+
+import (
+	"encoding/json"
+	"io"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// RdjsonReporter prints failures in the Reviewdog Diagnostic Format as a JSON document.
+// Refer to https://github.com/reviewdog/reviewdog/tree/master/proto/rdf
+// for details to the format.
+//
+// With the run information, the diagnostics of the fixable rules include
+// the suggestions which reviewdog posts as suggested changes.
+type RdjsonReporter struct{}
+
+// RdjsonlReporter prints failures in the Reviewdog Diagnostic Format as JSON Lines,
+// a diagnostic per line.
+type RdjsonlReporter struct{}
+
+type rdjsonSource struct {
+	Name string `json:"name"`
+	URL  string `json:"url,omitempty"`
+}
+
+type rdjsonPosition struct {
+	Line   int `json:"line"`
+	Column int `json:"column,omitempty"`
+}
+
+type rdjsonRange struct {
+	Start rdjsonPosition  `json:"start"`
+	End   *rdjsonPosition `json:"end,omitempty"`
+}
+
+type rdjsonLocation struct {
+	Path  string      `json:"path"`
+	Range rdjsonRange `json:"range"`
+}
+
+type rdjsonCode struct {
+	Value string `json:"value"`
+	URL   string `json:"url,omitempty"`
+}
+
+type rdjsonSuggestion struct {
+	Range rdjsonRange `json:"range"`
+	Text  string      `json:"text"`
+}
+
+type rdjsonDiagnostic struct {
+	Message     string             `json:"message"`
+	Location    rdjsonLocation     `json:"location"`
+	Severity    string             `json:"severity"`
+	Source      *rdjsonSource      `json:"source,omitempty"`
+	Code        rdjsonCode         `json:"code"`
+	Suggestions []rdjsonSuggestion `json:"suggestions,omitempty"`
+}
+
+type rdjsonResult struct {
+	Source      rdjsonSource       `json:"source"`
+	Diagnostics []rdjsonDiagnostic `json:"diagnostics"`
+}
+
+var rdjsonProtolint = rdjsonSource{
+	Name: "protolint",
+	URL:  protolintInformationURI,
+}
+
+// Report writes failures to w.
+func (r RdjsonReporter) Report(w io.Writer, fs []report.Failure) error {
+	return r.ReportWithRunInfo(w, fs, internalreport.RunInfo{})
+}
+
+// ReportWithRunInfo writes failures to w with the suggestions.
+func (r RdjsonReporter) ReportWithRunInfo(w io.Writer, fs []report.Failure, info internalreport.RunInfo) error {
+	result := rdjsonResult{
+		Source:      rdjsonProtolint,
+		Diagnostics: newRdjsonDiagnostics(fs, info),
+	}
+	if result.Diagnostics == nil {
+		result.Diagnostics = []rdjsonDiagnostic{}
+	}
+
+	bs, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(bs, '\n'))
+	return err
+}
+
+// Report writes failures to w.
+func (r RdjsonlReporter) Report(w io.Writer, fs []report.Failure) error {
+	return r.ReportWithRunInfo(w, fs, internalreport.RunInfo{})
+}
+
+// ReportWithRunInfo writes failures to w with the suggestions.
+func (r RdjsonlReporter) ReportWithRunInfo(w io.Writer, fs []report.Failure, info internalreport.RunInfo) error {
+	enc := json.NewEncoder(w)
+	for _, d := range newRdjsonDiagnostics(fs, info) {
+		// Each line must tell the source by itself.
+		source := rdjsonProtolint
+		d.Source = &source
+		if err := enc.Encode(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newRdjsonDiagnostics(
+	fs []report.Failure,
+	info internalreport.RunInfo,
+) []rdjsonDiagnostic {
+	sources := make(sourceLines)
+	suggestions := assignRdjsonSuggestions(fs, info)
+
+	var diagnostics []rdjsonDiagnostic
+	for i, f := range fs {
+		filename := f.Pos().Filename
+		r := rdjsonRange{
+			Start: rdjsonPosition{
+				Line:   f.Pos().Line,
+				Column: byteColumn(sources, filename, f.Pos().Line, f.Pos().Column),
+			},
+		}
+		if f.HasRange() {
+			// The end is exclusive in the format.
+			r.End = &rdjsonPosition{
+				Line:   f.End().Line,
+				Column: byteColumn(sources, filename, f.End().Line, f.End().Column+1),
+			}
+		}
+
+		d, _ := info.Rule(f.RuleID())
+		diagnostic := rdjsonDiagnostic{
+			Message: f.Message(),
+			Location: rdjsonLocation{
+				Path:  filename,
+				Range: r,
+			},
+			Severity: getRdjsonSeverity(f.Severity()),
+			Code: rdjsonCode{
+				Value: f.RuleID(),
+				URL:   d.HelpURI,
+			},
+		}
+		for _, replacement := range suggestions[i] {
+			diagnostic.Suggestions = append(diagnostic.Suggestions, rdjsonSuggestion{
+				Range: rdjsonRange{
+					Start: rdjsonPosition{
+						Line:   replacement.StartLine,
+						Column: byteColumn(sources, filename, replacement.StartLine, replacement.StartColumn),
+					},
+					End: &rdjsonPosition{
+						Line:   replacement.EndLine,
+						Column: byteColumn(sources, filename, replacement.EndLine, replacement.EndColumn),
+					},
+				},
+				Text: replacement.Text,
+			})
+		}
+		diagnostics = append(diagnostics, diagnostic)
+	}
+	return diagnostics
+}
+
+// assignRdjsonSuggestions assigns the replacements of the fix of each failure to it,
+// leaving out the ones already assigned to another failure in the file,
+// so that reviewdog doesn't suggest the same change twice, like moving an import for both imports.
+func assignRdjsonSuggestions(
+	fs []report.Failure,
+	info internalreport.RunInfo,
+) map[int][]internalreport.Replacement {
+	type assignedKey struct {
+		filename    string
+		replacement internalreport.Replacement
+	}
+
+	suggestions := make(map[int][]internalreport.Replacement)
+	assigned := make(map[assignedKey]bool)
+	for i, f := range fs {
+		for _, replacement := range info.FixesFor(f) {
+			key := assignedKey{filename: f.Pos().Filename, replacement: replacement}
+			if assigned[key] {
+				continue
+			}
+			assigned[key] = true
+			suggestions[i] = append(suggestions[i], replacement)
+		}
+	}
+	return suggestions
+}
+
+// byteColumn converts the 1-based column counting the runes into the one counting the bytes in UTF-8.
+// It returns the column as it is if the line can't be read.
+func byteColumn(
+	sources sourceLines,
+	filename string,
+	line int,
+	column int,
+) int {
+	text, ok := sources.line(filename, line)
+	if !ok || column < 1 {
+		return column
+	}
+	runes := []rune(text)
+	n := column - 1
+	if len(runes) < n {
+		// Count a byte per column beyond the line, like the line break.
+		return len(text) + n - len(runes) + 1
+	}
+	return len(string(runes[:n])) + 1
+}
+
+func getRdjsonSeverity(severity string) string {
+	switch rule.Severity(severity) {
+	case rule.SeverityWarning:
+		return "WARNING"
+	case rule.SeverityNote:
+		return "INFO"
+	}
+	return "ERROR"
+}
@@ -0,0 +1,244 @@
+package reporters_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestRdjsonReporter_ReportWithRunInfo(t *testing.T) {
+	protoPath := filepath.Join(t.TempDir(), "example.proto")
+	err := os.WriteFile(protoPath, []byte(`syntax = "proto3";
+import "b.proto";
+import "a.proto";
+
+message FooBar {
+  /* ñ */ string FieldA = 1;
+}
+`), 0644)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	pos := func(line, column int) meta.Position {
+		return meta.Position{Filename: protoPath, Line: line, Column: column}
+	}
+	failures := []report.Failure{
+		report.FailureWithRangef(
+			pos(2, 1), pos(2, 17),
+			"IMPORTS_SORTED",
+			string(rule.SeverityWarning),
+			`Imports are not sorted.`,
+		),
+		report.FailureWithRangef(
+			pos(3, 1), pos(3, 17),
+			"IMPORTS_SORTED",
+			string(rule.SeverityWarning),
+			`Imports are not sorted.`,
+		),
+		report.FailureWithRangef(
+			pos(6, 11), pos(6, 28),
+			"FIELD_NAMES_LOWER_SNAKE_CASE",
+			string(rule.SeverityError),
+			`Field name "FieldA" must be underscore_separated_names`,
+		),
+		report.Failuref(
+			pos(1, 1),
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			`File should have a comment`,
+		),
+	}
+	importsSorted := []internalreport.Replacement{
+		{StartLine: 2, StartColumn: 1, EndLine: 3, EndColumn: 1},
+		{StartLine: 4, StartColumn: 1, EndLine: 4, EndColumn: 1, Text: "import \"b.proto\";\n"},
+	}
+	info := internalreport.RunInfo{
+		Rules: []internalreport.RuleDescriptor{
+			{ID: "IMPORTS_SORTED", HelpURI: "https://example.com/importsSortedRule.go", Fixable: true},
+			{ID: "FIELD_NAMES_LOWER_SNAKE_CASE", HelpURI: "https://example.com/fieldNamesLowerSnakeCaseRule.go", Fixable: true},
+		},
+		Fixes: []internalreport.Fix{
+			{
+				Failure:      failures[0],
+				Replacements: importsSorted,
+			},
+			{
+				Failure:      failures[1],
+				Replacements: importsSorted,
+			},
+			{
+				Failure: failures[2],
+				Replacements: []internalreport.Replacement{
+					{StartLine: 6, StartColumn: 1, EndLine: 6, EndColumn: 29, Text: "  /* ñ */ string field_a = 1;"},
+				},
+			},
+		},
+	}
+
+	t.Run("rdjson", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := reporters.RdjsonReporter{}.ReportWithRunInfo(buf, failures, info)
+		if err != nil {
+			t.Errorf("got err %v, but want nil", err)
+			return
+		}
+		want := strings.ReplaceAll(`{
+  "source": {
+    "name": "protolint",
+    "url": "https://github.com/maramkhaledn/protolint"
+  },
+  "diagnostics": [
+    {
+      "message": "Imports are not sorted.",
+      "location": {
+        "path": "PATH",
+        "range": {
+          "start": {
+            "line": 2,
+            "column": 1
+          },
+          "end": {
+            "line": 2,
+            "column": 18
+          }
+        }
+      },
+      "severity": "WARNING",
+      "code": {
+        "value": "IMPORTS_SORTED",
+        "url": "https://example.com/importsSortedRule.go"
+      },
+      "suggestions": [
+        {
+          "range": {
+            "start": {
+              "line": 2,
+              "column": 1
+            },
+            "end": {
+              "line": 3,
+              "column": 1
+            }
+          },
+          "text": ""
+        },
+        {
+          "range": {
+            "start": {
+              "line": 4,
+              "column": 1
+            },
+            "end": {
+              "line": 4,
+              "column": 1
+            }
+          },
+          "text": "import \"b.proto\";\n"
+        }
+      ]
+    },
+    {
+      "message": "Imports are not sorted.",
+      "location": {
+        "path": "PATH",
+        "range": {
+          "start": {
+            "line": 3,
+            "column": 1
+          },
+          "end": {
+            "line": 3,
+            "column": 18
+          }
+        }
+      },
+      "severity": "WARNING",
+      "code": {
+        "value": "IMPORTS_SORTED",
+        "url": "https://example.com/importsSortedRule.go"
+      }
+    },
+    {
+      "message": "Field name \"FieldA\" must be underscore_separated_names",
+      "location": {
+        "path": "PATH",
+        "range": {
+          "start": {
+            "line": 6,
+            "column": 12
+          },
+          "end": {
+            "line": 6,
+            "column": 30
+          }
+        }
+      },
+      "severity": "ERROR",
+      "code": {
+        "value": "FIELD_NAMES_LOWER_SNAKE_CASE",
+        "url": "https://example.com/fieldNamesLowerSnakeCaseRule.go"
+      },
+      "suggestions": [
+        {
+          "range": {
+            "start": {
+              "line": 6,
+              "column": 1
+            },
+            "end": {
+              "line": 6,
+              "column": 30
+            }
+          },
+          "text": "  /* ñ */ string field_a = 1;"
+        }
+      ]
+    },
+    {
+      "message": "File should have a comment",
+      "location": {
+        "path": "PATH",
+        "range": {
+          "start": {
+            "line": 1,
+            "column": 1
+          }
+        }
+      },
+      "severity": "INFO",
+      "code": {
+        "value": "FILE_HAS_COMMENT"
+      }
+    }
+  ]
+}
+`, "PATH", protoPath)
+		if buf.String() != want {
+			t.Errorf("got %s, but want %s", buf.String(), want)
+		}
+	})
+
+	t.Run("rdjsonl", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		err := reporters.RdjsonlReporter{}.ReportWithRunInfo(buf, failures[3:], info)
+		if err != nil {
+			t.Errorf("got err %v, but want nil", err)
+			return
+		}
+		want := strings.ReplaceAll(`{"message":"File should have a comment","location":{"path":"PATH","range":{"start":{"line":1,"column":1}}},"severity":"INFO","source":{"name":"protolint","url":"https://github.com/maramkhaledn/protolint"},"code":{"value":"FILE_HAS_COMMENT"}}
+`, "PATH", protoPath)
+		if buf.String() != want {
+			t.Errorf("got %s, but want %s", buf.String(), want)
+		}
+	})
+}
@@ -4,16 +4,23 @@ import (
 	"io"
 
 	"github.com/chavacava/garif"
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/osutil"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
 )
 
+const protolintInformationURI = "https://github.com/maramkhaledn/protolint"
+
 // SarifReporter creates reports formatted as a JSON
 // Document.
 // The document format is used according to the SARIF
 // Standard.
 // Refer to http://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
 // for details to the format.
+//
+// With the run information, the document also includes the rule descriptors,
+// the fixes, the suppressed results and the invocation.
 type SarifReporter struct{}
 
 var allSeverities map[string]rule.Severity = map[string]rule.Severity{
@@ -33,22 +40,39 @@ func contains(s []string, e string) bool {
 
 // Report writes failures to w formatted as a SARIF document.
 func (r SarifReporter) Report(w io.Writer, fs []report.Failure) error {
+	return r.report(w, fs, nil)
+}
+
+// ReportWithRunInfo writes failures to w formatted as a SARIF document with the run information.
+func (r SarifReporter) ReportWithRunInfo(w io.Writer, fs []report.Failure, info internalreport.RunInfo) error {
+	return r.report(w, fs, &info)
+}
+
+func (r SarifReporter) report(w io.Writer, fs []report.Failure, info *internalreport.RunInfo) error {
 	rulesByID := make(map[string]*garif.ReportingDescriptor)
 	allRules := []*garif.ReportingDescriptor{}
 	artifactLocations := []string{}
 
 	tool := garif.NewDriver("protolint").
-		WithInformationUri("https://github.com/maramkhaledn/protolint")
+		WithInformationUri(protolintInformationURI)
 
 	run := garif.NewRun(garif.NewTool(tool))
 
-	for _, failure := range fs {
+	if info != nil {
+		for _, d := range info.Rules {
+			rule := newSarifRule(d)
+			rulesByID[d.ID] = rule
+			allRules = append(allRules, rule)
+		}
+	}
+
+	addResult := func(failure report.Failure) *garif.Result {
 		_, ruleFound := rulesByID[failure.RuleID()]
 		if !ruleFound {
 			rule := garif.NewRule(
 				failure.RuleID(),
 			).
-				WithHelpUri("https://github.com/maramkhaledn/protolint")
+				WithHelpUri(protolintInformationURI)
 
 			rulesByID[failure.RuleID()] = rule
 			allRules = append(allRules, rule)
@@ -66,17 +90,40 @@ func (r SarifReporter) Report(w io.Writer, fs []report.Failure) error {
 			failure.Pos().Column,
 		)
 
-		if len(run.Results) > 0 {
+		recentResult := run.Results[len(run.Results)-1]
+		recentResult.Kind = garif.ResultKind_Fail
+
+		if failure.HasRange() {
+			region := recentResult.Locations[0].PhysicalLocation.Region
+			region.EndLine = failure.End().Line
+			// SARIF regards endColumn as the column after the last character.
+			region.EndColumn = failure.End().Column + 1
+		}
 
-			recentResult := run.Results[len(run.Results)-1]
-			recentResult.Kind = garif.ResultKind_Fail
+		if lvl, ok := allSeverities[failure.Severity()]; ok {
+			recentResult.Level = getResultLevel(lvl)
+		}
+		return recentResult
+	}
 
-			if lvl, ok := allSeverities[failure.Severity()]; ok {
-				recentResult.Level = getResultLevel(lvl)
-			}
+	for _, failure := range fs {
+		result := addResult(failure)
+		if info == nil {
+			continue
+		}
+		if fixes := info.FixesFor(failure); 0 < len(fixes) {
+			result.Fixes = []*garif.Fix{newSarifFix(failure.Pos().Filename, fixes)}
 		}
 	}
 
+	if info != nil {
+		for _, failure := range info.Suppressed {
+			result := addResult(failure)
+			result.Suppressions = []*garif.Suppression{garif.NewSuppression("inSource")}
+		}
+		run.Invocations = []*garif.Invocation{newSarifInvocation(*info)}
+	}
+
 	tool.WithRules(allRules...)
 	run.WithArtifactsURIs(artifactLocations...)
 
@@ -84,6 +131,58 @@ func (r SarifReporter) Report(w io.Writer, fs []report.Failure) error {
 	return logFile.PrettyWrite(w)
 }
 
+func newSarifRule(d internalreport.RuleDescriptor) *garif.ReportingDescriptor {
+	helpURI := d.HelpURI
+	if len(helpURI) == 0 {
+		helpURI = protolintInformationURI
+	}
+	rule := garif.NewRule(d.ID).WithHelpUri(helpURI)
+	rule.ShortDescription = garif.NewMultiformatMessageString(d.Purpose)
+	rule.DefaultConfiguration = garif.NewReportingConfiguration()
+	rule.DefaultConfiguration.Level = getResultLevel(d.Severity)
+	if d.Fixable {
+		rule.Properties = &garif.PropertyBag{"fixable": true}
+	}
+	return rule
+}
+
+func newSarifFix(
+	filename string,
+	replacements []internalreport.Replacement,
+) *garif.Fix {
+	location := garif.NewArtifactLocation()
+	location.Uri = filename
+
+	var sarifReplacements []*garif.Replacement
+	for _, r := range replacements {
+		region := garif.NewRegion()
+		region.StartLine = r.StartLine
+		region.StartColumn = r.StartColumn
+		region.EndLine = r.EndLine
+		region.EndColumn = r.EndColumn
+
+		replacement := garif.NewReplacement(region)
+		if 0 < len(r.Text) {
+			replacement.InsertedContent = garif.NewArtifactContent()
+			replacement.InsertedContent.Text = r.Text
+		}
+		sarifReplacements = append(sarifReplacements, replacement)
+	}
+
+	fix := garif.NewFix(garif.NewArtifactChange(location, sarifReplacements...))
+	fix.Description = garif.NewMessageFromText("Fix with protolint -fix")
+	return fix
+}
+
+func newSarifInvocation(info internalreport.RunInfo) *garif.Invocation {
+	invocation := garif.NewInvocation(info.ExitCode != osutil.ExitInternalFailure)
+	invocation.ExitCode = int(info.ExitCode)
+	if 0 < len(info.ConfigPath) {
+		invocation.Properties = &garif.PropertyBag{"configPath": info.ConfigPath}
+	}
+	return invocation
+}
+
 func getResultLevel(severity rule.Severity) garif.ResultLevel {
 	switch severity {
 	case rule.SeverityError:
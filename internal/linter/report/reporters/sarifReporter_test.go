@@ -6,7 +6,9 @@ import (
 
 	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
 
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
 	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/internal/osutil"
 	"github.com/maramkhaledn/protolint/linter/report"
 	"github.com/maramkhaledn/protolint/linter/rule"
 )
@@ -112,6 +114,79 @@ func TestSarifReporter_Report(t *testing.T) {
     }
   ],
   "version": "2.1.0"
+}`,
+		},
+		{
+			name: "Prints failures with the regions",
+			inputFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   125,
+						Line:     5,
+						Column:   35,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantOutput: `{
+  "runs": [
+    {
+      "artifacts": [
+        {
+          "location": {
+            "uri": "example.proto"
+          }
+        }
+      ],
+      "results": [
+        {
+          "kind": "fail",
+          "level": "error",
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "example.proto"
+                },
+                "region": {
+                  "endColumn": 36,
+                  "endLine": 5,
+                  "startColumn": 10,
+                  "startLine": 5
+                }
+              }
+            }
+          ],
+          "message": {
+            "text": "EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES"
+          },
+          "ruleId": "ENUM_NAMES_UPPER_CAMEL_CASE"
+        }
+      ],
+      "tool": {
+        "driver": {
+          "informationUri": "https://github.com/maramkhaledn/protolint",
+          "name": "protolint",
+          "rules": [
+            {
+              "helpUri": "https://github.com/maramkhaledn/protolint",
+              "id": "ENUM_NAMES_UPPER_CAMEL_CASE"
+            }
+          ]
+        }
+      }
+    }
+  ],
+  "version": "2.1.0"
 }`,
 		},
 	}
@@ -131,3 +206,214 @@ func TestSarifReporter_Report(t *testing.T) {
 		})
 	}
 }
+
+func TestSarifReporter_ReportWithRunInfo(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		inputInfo     internalreport.RunInfo
+		wantOutput    string
+	}{
+		{
+			name: "Prints failures with the run information",
+			inputFailures: []report.Failure{
+				report.Failuref(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					"FIELD_NAMES_LOWER_SNAKE_CASE",
+					string(rule.SeverityError),
+					`Field name "FieldB" must be underscore_separated_names`,
+				),
+			},
+			inputInfo: internalreport.RunInfo{
+				Rules: []internalreport.RuleDescriptor{
+					{
+						ID:       "FIELD_NAMES_LOWER_SNAKE_CASE",
+						Purpose:  "Verifies that all field names are underscore_separated_names.",
+						Severity: rule.SeverityError,
+						HelpURI:  "https://example.com/fieldNamesLowerSnakeCaseRule.go",
+						Fixable:  true,
+					},
+				},
+				Suppressed: []report.Failure{
+					report.Failuref(
+						meta.Position{
+							Filename: "example.proto",
+							Offset:   80,
+							Line:     4,
+							Column:   10,
+						},
+						"FIELD_NAMES_LOWER_SNAKE_CASE",
+						string(rule.SeverityError),
+						`Field name "FieldA" must be underscore_separated_names`,
+					),
+				},
+				Fixes: []internalreport.Fix{
+					{
+						Failure: report.Failuref(
+							meta.Position{
+								Filename: "example.proto",
+								Offset:   100,
+								Line:     5,
+								Column:   10,
+							},
+							"FIELD_NAMES_LOWER_SNAKE_CASE",
+							string(rule.SeverityError),
+							`Field name "FieldB" must be underscore_separated_names`,
+						),
+						Replacements: []internalreport.Replacement{
+							{
+								StartLine:   5,
+								StartColumn: 1,
+								EndLine:     5,
+								EndColumn:   21,
+								Text:        "  string field_b = 2;",
+							},
+						},
+					},
+				},
+				ConfigPath: ".protolint.yaml",
+				ExitCode:   osutil.ExitLintFailure,
+			},
+			wantOutput: `{
+  "runs": [
+    {
+      "artifacts": [
+        {
+          "location": {
+            "uri": "example.proto"
+          }
+        }
+      ],
+      "invocations": [
+        {
+          "executionSuccessful": true,
+          "exitCode": 1,
+          "properties": {
+            "configPath": ".protolint.yaml"
+          }
+        }
+      ],
+      "results": [
+        {
+          "fixes": [
+            {
+              "artifactChanges": [
+                {
+                  "artifactLocation": {
+                    "uri": "example.proto"
+                  },
+                  "replacements": [
+                    {
+                      "deletedRegion": {
+                        "endColumn": 21,
+                        "endLine": 5,
+                        "startColumn": 1,
+                        "startLine": 5
+                      },
+                      "insertedContent": {
+                        "text": "  string field_b = 2;"
+                      }
+                    }
+                  ]
+                }
+              ],
+              "description": {
+                "text": "Fix with protolint -fix"
+              }
+            }
+          ],
+          "kind": "fail",
+          "level": "error",
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "example.proto"
+                },
+                "region": {
+                  "startColumn": 10,
+                  "startLine": 5
+                }
+              }
+            }
+          ],
+          "message": {
+            "text": "Field name \"FieldB\" must be underscore_separated_names"
+          },
+          "ruleId": "FIELD_NAMES_LOWER_SNAKE_CASE"
+        },
+        {
+          "kind": "fail",
+          "level": "error",
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "example.proto"
+                },
+                "region": {
+                  "startColumn": 10,
+                  "startLine": 4
+                }
+              }
+            }
+          ],
+          "message": {
+            "text": "Field name \"FieldA\" must be underscore_separated_names"
+          },
+          "ruleId": "FIELD_NAMES_LOWER_SNAKE_CASE",
+          "suppressions": [
+            {
+              "kind": "inSource"
+            }
+          ]
+        }
+      ],
+      "tool": {
+        "driver": {
+          "informationUri": "https://github.com/maramkhaledn/protolint",
+          "name": "protolint",
+          "rules": [
+            {
+              "defaultConfiguration": {
+                "level": "error"
+              },
+              "helpUri": "https://example.com/fieldNamesLowerSnakeCaseRule.go",
+              "id": "FIELD_NAMES_LOWER_SNAKE_CASE",
+              "properties": {
+                "fixable": true
+              },
+              "shortDescription": {
+                "text": "Verifies that all field names are underscore_separated_names."
+              }
+            }
+          ]
+        }
+      }
+    }
+  ],
+  "version": "2.1.0"
+}`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.SarifReporter{}.ReportWithRunInfo(buf, test.inputFailures, test.inputInfo)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
@@ -23,6 +23,8 @@ type SonarReporter struct {
 type sonarTextRange struct {
 	StartLine   int `json:"startLine"`
 	StartColumn int `json:"startColumn"`
+	EndLine     int `json:"endLine,omitempty"`
+	EndColumn   int `json:"endColumn,omitempty"`
 }
 
 type sonarLocation struct {
@@ -42,18 +44,25 @@ type sonarIssue struct {
 func (s SonarReporter) Report(w io.Writer, fs []report.Failure) error {
 	var issues []sonarIssue
 	for _, f := range fs {
+		textRange := sonarTextRange{
+			StartLine:   f.Pos().Line,
+			StartColumn: f.Pos().Column,
+		}
+		if f.HasRange() {
+			textRange.EndLine = f.End().Line
+			// Sonar counts the columns from 0 and excludes endColumn, which is the 1-based last column.
+			textRange.EndColumn = f.End().Column
+		}
+
 		issue := sonarIssue{
 			EngineId:  protolintSonarEngineId,
 			RuleId:    f.RuleID(),
 			IssueType: protolintSonarIssueType,
 			Severity:  getSonarSeverity(f.Severity()),
 			PrimaryLocation: sonarLocation{
-				Message:  f.Message(),
-				FilePath: f.Pos().Filename,
-				TextRange: sonarTextRange{
-					StartLine:   f.Pos().Line,
-					StartColumn: f.Pos().Column,
-				},
+				Message:   f.Message(),
+				FilePath:  f.Pos().Filename,
+				TextRange: textRange,
 			},
 		}
 
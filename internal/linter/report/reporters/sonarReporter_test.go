@@ -72,6 +72,46 @@ func TestSonarReporter_Report(t *testing.T) {
     "severity": "MAJOR",
     "issueType": "CODE_SMELL"
   }
+]`,
+		},
+		{
+			name: "Prints failures with the text ranges",
+			inputFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   125,
+						Line:     5,
+						Column:   35,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantOutput: `[
+  {
+    "engineId": "protolint",
+    "ruleId": "ENUM_NAMES_UPPER_CAMEL_CASE",
+    "primaryLocation": {
+      "message": "EnumField name \"fIRST_VALUE\" must be CAPITALS_WITH_UNDERSCORES",
+      "filePath": "example.proto",
+      "textRange": {
+        "startLine": 5,
+        "startColumn": 10,
+        "endLine": 5,
+        "endColumn": 35
+      }
+    },
+    "severity": "MAJOR",
+    "issueType": "CODE_SMELL"
+  }
 ]`,
 		},
 	}
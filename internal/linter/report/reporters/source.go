@@ -0,0 +1,32 @@
+package reporters
+
+import (
+	"os"
+	"strings"
+)
+
+// sourceLines reads the lines of the linted files for the reporters which print the source.
+// It reads each file once and regards an unreadable file as empty.
+type sourceLines map[string][]string
+
+func (s sourceLines) get(filename string) []string {
+	lines, ok := s[filename]
+	if ok {
+		return lines
+	}
+	content, err := os.ReadFile(filename)
+	if err == nil {
+		lines = strings.Split(strings.ReplaceAll(string(content), "\r\n", "\n"), "\n")
+	}
+	s[filename] = lines
+	return lines
+}
+
+// line returns the 1-based line of the file.
+func (s sourceLines) line(filename string, line int) (string, bool) {
+	lines := s.get(filename)
+	if line < 1 || len(lines) < line {
+		return "", false
+	}
+	return lines[line-1], true
+}
@@ -0,0 +1,112 @@
+package reporters
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// SummaryReporter prints the statistics of the run instead of each failure.
+//
+// It prints the counts of the failures per rule, per severity and per top-level directory,
+// the numbers of the linted and skipped files and the applied fixes, and the time spent per rule.
+type SummaryReporter struct{}
+
+// UsesOnlyRules tells that the reporter needs no suppressed failures and fixes.
+func (r SummaryReporter) UsesOnlyRules() bool {
+	return true
+}
+
+// Report writes the counts of failures to w.
+func (r SummaryReporter) Report(w io.Writer, fs []report.Failure) error {
+	return r.report(w, fs, nil)
+}
+
+// ReportWithRunInfo writes the counts of failures and the statistics of the run to w.
+func (r SummaryReporter) ReportWithRunInfo(w io.Writer, fs []report.Failure, info internalreport.RunInfo) error {
+	return r.report(w, fs, &info)
+}
+
+func (r SummaryReporter) report(w io.Writer, fs []report.Failure, info *internalreport.RunInfo) error {
+	byRule := make(map[string]int)
+	bySeverity := make(map[string]int)
+	byDirectory := make(map[string]int)
+	for _, f := range fs {
+		byRule[f.RuleID()]++
+		bySeverity[f.Severity()]++
+		byDirectory[topLevelDir(f.Pos().Filename)]++
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(tw, "Failures: %d\n", len(fs))
+
+	fmt.Fprintln(tw, "Failures by rule:")
+	for _, c := range sortedCounts(byRule) {
+		fmt.Fprintf(tw, "  %s\t%d\n", c.Key, c.Count)
+	}
+
+	fmt.Fprintln(tw, "Failures by severity:")
+	for _, s := range []rule.Severity{rule.SeverityError, rule.SeverityWarning, rule.SeverityNote} {
+		fmt.Fprintf(tw, "  %s\t%d\n", s, bySeverity[string(s)])
+	}
+
+	fmt.Fprintln(tw, "Failures by directory:")
+	for _, c := range sortedCounts(byDirectory) {
+		fmt.Fprintf(tw, "  %s\t%d\n", c.Key, c.Count)
+	}
+
+	if info != nil {
+		stats := info.Stats
+		fmt.Fprintf(tw, "Files: %d linted, %d skipped\n", stats.FilesLinted, stats.FilesSkipped)
+		fmt.Fprintf(tw, "Fixes applied: %d\n", stats.FixesApplied)
+
+		fmt.Fprintln(tw, "Time by rule:")
+		for _, e := range sortedElapsed(stats.RuleElapsed) {
+			fmt.Fprintf(tw, "  %s\t%s\n", e.id, e.elapsed.Round(time.Microsecond))
+		}
+	}
+	return tw.Flush()
+}
+
+// topLevelDir returns the first directory of the path, or "." if the path has no directory.
+func topLevelDir(path string) string {
+	path = filepath.ToSlash(filepath.Clean(path))
+	prefix := ""
+	if strings.HasPrefix(path, "/") {
+		prefix = "/"
+		path = path[1:]
+	}
+	i := strings.Index(path, "/")
+	if i < 0 {
+		return "."
+	}
+	return prefix + path[:i]
+}
+
+type ruleElapsed struct {
+	id      string
+	elapsed time.Duration
+}
+
+// sortedElapsed sorts the rules from the slowest, and then by the ID.
+func sortedElapsed(elapsed map[string]time.Duration) []ruleElapsed {
+	var sorted []ruleElapsed
+	for id, d := range elapsed {
+		sorted = append(sorted, ruleElapsed{id: id, elapsed: d})
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].elapsed != sorted[j].elapsed {
+			return sorted[i].elapsed > sorted[j].elapsed
+		}
+		return sorted[i].id < sorted[j].id
+	})
+	return sorted
+}
@@ -0,0 +1,111 @@
+package reporters_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestSummaryReporter_ReportWithRunInfo(t *testing.T) {
+	failureAt := func(filename string, ruleID string, severity rule.Severity) report.Failure {
+		return report.Failuref(
+			meta.Position{Filename: filename, Line: 1, Column: 1},
+			ruleID,
+			string(severity),
+			"message",
+		)
+	}
+
+	tests := []struct {
+		name          string
+		inputFailures []report.Failure
+		inputInfo     internalreport.RunInfo
+		wantOutput    string
+	}{
+		{
+			name: "Prints the statistics without failures",
+			inputInfo: internalreport.RunInfo{
+				Stats: internalreport.Stats{
+					FilesLinted:  1,
+					FilesSkipped: 2,
+				},
+			},
+			wantOutput: `Failures: 0
+Failures by rule:
+Failures by severity:
+  error    0
+  warning  0
+  note     0
+Failures by directory:
+Files: 1 linted, 2 skipped
+Fixes applied: 0
+Time by rule:
+`,
+		},
+		{
+			name: "Prints the statistics",
+			inputFailures: []report.Failure{
+				failureAt("proto/a/a.proto", "ENUM_NAMES_UPPER_CAMEL_CASE", rule.SeverityError),
+				failureAt("proto/b/b.proto", "FIELD_NAMES_LOWER_SNAKE_CASE", rule.SeverityWarning),
+				failureAt("api/c.proto", "FIELD_NAMES_LOWER_SNAKE_CASE", rule.SeverityError),
+				failureAt("d.proto", "FILE_HAS_COMMENT", rule.SeverityNote),
+				failureAt("/abs/e.proto", "FILE_HAS_COMMENT", rule.SeverityNote),
+				failureAt("proto/f.proto", "FILE_HAS_COMMENT", rule.SeverityNote),
+			},
+			inputInfo: internalreport.RunInfo{
+				Stats: internalreport.Stats{
+					FilesLinted:  6,
+					FixesApplied: 3,
+					RuleElapsed: map[string]time.Duration{
+						"ENUM_NAMES_UPPER_CAMEL_CASE":  1500 * time.Microsecond,
+						"FIELD_NAMES_LOWER_SNAKE_CASE": 2 * time.Millisecond,
+						"FILE_HAS_COMMENT":             1500*time.Microsecond + 300*time.Nanosecond,
+					},
+				},
+			},
+			wantOutput: `Failures: 6
+Failures by rule:
+  FILE_HAS_COMMENT              3
+  FIELD_NAMES_LOWER_SNAKE_CASE  2
+  ENUM_NAMES_UPPER_CAMEL_CASE   1
+Failures by severity:
+  error    2
+  warning  1
+  note     3
+Failures by directory:
+  proto  3
+  .      1
+  /abs   1
+  api    1
+Files: 6 linted, 0 skipped
+Fixes applied: 3
+Time by rule:
+  FIELD_NAMES_LOWER_SNAKE_CASE  2ms
+  FILE_HAS_COMMENT              1.5ms
+  ENUM_NAMES_UPPER_CAMEL_CASE   1.5ms
+`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			err := reporters.SummaryReporter{}.ReportWithRunInfo(buf, test.inputFailures, test.inputInfo)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
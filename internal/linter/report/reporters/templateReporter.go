@@ -0,0 +1,215 @@
+package reporters
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// templateFuncs is the helper functions available in the templates of the reporters.
+var templateFuncs = template.FuncMap{
+	"ToUpper":   strings.ToUpper,
+	"ToLower":   strings.ToLower,
+	"TrimSpace": strings.TrimSpace,
+	"Replace":   strings.ReplaceAll,
+	"Join":      strings.Join,
+	"Repeat":    strings.Repeat,
+	"Contains":  strings.Contains,
+	"HasPrefix": strings.HasPrefix,
+	"HasSuffix": strings.HasSuffix,
+	"Quote":     strconv.Quote,
+	"Base":      filepath.Base,
+	"Dir":       filepath.Dir,
+	"Add": func(a, b int) int {
+		return a + b
+	},
+	"Plural": func(n int, singular, plural string) string {
+		if n == 1 {
+			return singular
+		}
+		return plural
+	},
+	"JSON": func(v interface{}) (string, error) {
+		bs, err := json.Marshal(v)
+		return string(bs), err
+	},
+	"EscapeXML": func(s string) (string, error) {
+		var b strings.Builder
+		err := xml.EscapeText(&b, []byte(s))
+		return b.String(), err
+	},
+	"EscapeMarkdown": escapeMarkdown,
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`,
+	"<", `\<`, ">", `\>`, "#", `\#`, "|", `\|`,
+)
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+// TemplateReporter prints failures as a whole document rendered by a Go template.
+// Refer to https://pkg.go.dev/text/template for details to the syntax.
+//
+// The template is executed once with a templateDocument, so that it can print
+// a header and a footer, and group the failures by file or by rule.
+type TemplateReporter struct {
+	template *template.Template
+}
+
+// NewTemplateReporter creates a TemplateReporter from the template file.
+func NewTemplateReporter(path string) (TemplateReporter, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return TemplateReporter{}, err
+	}
+	t, err := template.New(filepath.Base(path)).Funcs(templateFuncs).Parse(string(content))
+	if err != nil {
+		return TemplateReporter{}, fmt.Errorf("failed to parse the template %s: %v", path, err)
+	}
+	return TemplateReporter{
+		template: t,
+	}, nil
+}
+
+// templateFailure is a failure passed to the template.
+type templateFailure struct {
+	File      string
+	Line      int
+	Column    int
+	EndLine   int
+	EndColumn int
+	Severity  string
+	Rule      string
+	Message   string
+	// Source is the source line of the failure, or empty if the file can't be read.
+	Source string
+	// Purpose is the purpose of the rule, or empty without the run information.
+	Purpose string
+}
+
+// templateFile groups the failures of a file.
+type templateFile struct {
+	Name     string
+	Failures []templateFailure
+}
+
+// templateRule groups the failures of a rule.
+type templateRule struct {
+	ID       string
+	Purpose  string
+	Severity string
+	HelpURI  string
+	Fixable  bool
+	Failures []templateFailure
+}
+
+// templateDocument is passed to the template.
+type templateDocument struct {
+	Failures []templateFailure
+	// Files are in order of appearance.
+	Files []templateFile
+	// Rules are in order of appearance.
+	Rules    []templateRule
+	Total    int
+	Errors   int
+	Warnings int
+	Notes    int
+	ExitCode int
+}
+
+// UsesOnlyRules tells that the reporter needs only the rules for their purposes.
+func (r TemplateReporter) UsesOnlyRules() bool {
+	return true
+}
+
+// Report writes failures to w.
+func (r TemplateReporter) Report(w io.Writer, fs []report.Failure) error {
+	return r.ReportWithRunInfo(w, fs, internalreport.RunInfo{})
+}
+
+// ReportWithRunInfo writes failures to w with the rule purposes.
+func (r TemplateReporter) ReportWithRunInfo(w io.Writer, fs []report.Failure, info internalreport.RunInfo) error {
+	return r.template.Execute(w, newTemplateDocument(fs, info))
+}
+
+func newTemplateDocument(
+	fs []report.Failure,
+	info internalreport.RunInfo,
+) templateDocument {
+	sources := make(sourceLines)
+	doc := templateDocument{
+		Total:    len(fs),
+		ExitCode: int(info.ExitCode),
+	}
+	fileIndexes := make(map[string]int)
+	ruleIndexes := make(map[string]int)
+
+	for _, f := range fs {
+		d, _ := info.Rule(f.RuleID())
+		source, _ := sources.line(f.Pos().Filename, f.Pos().Line)
+		failure := templateFailure{
+			File:     f.Pos().Filename,
+			Line:     f.Pos().Line,
+			Column:   f.Pos().Column,
+			Severity: f.Severity(),
+			Rule:     f.RuleID(),
+			Message:  f.Message(),
+			Source:   source,
+			Purpose:  d.Purpose,
+		}
+		if f.HasRange() {
+			failure.EndLine = f.End().Line
+			failure.EndColumn = f.End().Column
+		}
+		doc.Failures = append(doc.Failures, failure)
+
+		switch rule.Severity(f.Severity()) {
+		case rule.SeverityError:
+			doc.Errors++
+		case rule.SeverityWarning:
+			doc.Warnings++
+		case rule.SeverityNote:
+			doc.Notes++
+		}
+
+		i, ok := fileIndexes[failure.File]
+		if !ok {
+			i = len(doc.Files)
+			fileIndexes[failure.File] = i
+			doc.Files = append(doc.Files, templateFile{Name: failure.File})
+		}
+		doc.Files[i].Failures = append(doc.Files[i].Failures, failure)
+
+		i, ok = ruleIndexes[failure.Rule]
+		if !ok {
+			i = len(doc.Rules)
+			ruleIndexes[failure.Rule] = i
+			severity := string(d.Severity)
+			if len(severity) == 0 {
+				severity = failure.Severity
+			}
+			doc.Rules = append(doc.Rules, templateRule{
+				ID:       failure.Rule,
+				Purpose:  d.Purpose,
+				Severity: severity,
+				HelpURI:  d.HelpURI,
+				Fixable:  d.Fixable,
+			})
+		}
+		doc.Rules[i].Failures = append(doc.Rules[i].Failures, failure)
+	}
+	return doc
+}
@@ -0,0 +1,159 @@
+package reporters_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/linter/report/reporters"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+func TestTemplateReporter_ReportWithRunInfo(t *testing.T) {
+	dir := t.TempDir()
+	protoPath := filepath.Join(dir, "example.proto")
+	err := os.WriteFile(protoPath, []byte("syntax = \"proto3\";\n\nenum Enum {\n  fIRST_VALUE = 0;\n}\n"), 0644)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	failures := []report.Failure{
+		report.FailureWithRangef(
+			meta.Position{Filename: protoPath, Line: 4, Column: 3},
+			meta.Position{Filename: protoPath, Line: 4, Column: 18},
+			"ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+			string(rule.SeverityError),
+			`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+		),
+		report.Failuref(
+			meta.Position{Filename: "other.proto", Line: 1, Column: 1},
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			`File should have a comment`,
+		),
+		report.Failuref(
+			meta.Position{Filename: protoPath, Line: 1, Column: 1},
+			"FILE_HAS_COMMENT",
+			string(rule.SeverityNote),
+			`File should have a comment`,
+		),
+	}
+	info := internalreport.RunInfo{
+		Rules: []internalreport.RuleDescriptor{
+			{
+				ID:       "ENUM_FIELD_NAMES_UPPER_SNAKE_CASE",
+				Purpose:  "Verifies that all enum field names are CAPITALS_WITH_UNDERSCORES.",
+				Severity: rule.SeverityError,
+				Fixable:  true,
+			},
+		},
+		ExitCode: 1,
+	}
+
+	tests := []struct {
+		name          string
+		inputTemplate string
+		wantOutput    string
+	}{
+		{
+			name: "Groups the failures by file with the source lines",
+			inputTemplate: `# {{.Total}} {{Plural .Total "failure" "failures"}} ({{.Errors}} errors, {{.Warnings}} warnings, {{.Notes}} notes)
+{{range .Files}}## {{Base .Name}}
+{{range .Failures}}- {{.Line}}:{{.Column}}{{if .EndLine}}-{{.EndLine}}:{{.EndColumn}}{{end}} {{EscapeMarkdown .Rule}}: {{.Message}}{{if .Source}}
+  ` + "`{{TrimSpace .Source}}`" + `{{end}}
+{{end}}{{end}}exit {{.ExitCode}}
+`,
+			wantOutput: `# 3 failures (1 errors, 0 warnings, 2 notes)
+## example.proto
+- 4:3-4:18 ENUM\_FIELD\_NAMES\_UPPER\_SNAKE\_CASE: EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES
+  ` + "`fIRST_VALUE = 0;`" + `
+- 1:1 FILE\_HAS\_COMMENT: File should have a comment
+  ` + "`syntax = \"proto3\";`" + `
+## other.proto
+- 1:1 FILE\_HAS\_COMMENT: File should have a comment
+exit 1
+`,
+		},
+		{
+			name: "Groups the failures by rule with the purposes",
+			inputTemplate: `<rules>{{range .Rules}}
+  <rule id="{{.ID}}" severity="{{.Severity}}" fixable="{{.Fixable}}" count="{{len .Failures}}">{{EscapeXML .Purpose}}</rule>{{end}}
+</rules>
+`,
+			wantOutput: `<rules>
+  <rule id="ENUM_FIELD_NAMES_UPPER_SNAKE_CASE" severity="error" fixable="true" count="1">Verifies that all enum field names are CAPITALS_WITH_UNDERSCORES.</rule>
+  <rule id="FILE_HAS_COMMENT" severity="note" fixable="false" count="2"></rule>
+</rules>
+`,
+		},
+		{
+			name:          "Prints the values as JSON",
+			inputTemplate: `{{with index .Failures 1}}{{JSON .Message}} {{ToLower .Severity}} {{Add .Line 1}}{{end}}`,
+			wantOutput:    `"File should have a comment" note 2`,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			templatePath := filepath.Join(t.TempDir(), "report.tmpl")
+			if err := os.WriteFile(templatePath, []byte(test.inputTemplate), 0644); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			r, err := reporters.NewTemplateReporter(templatePath)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			buf := &bytes.Buffer{}
+			err = r.ReportWithRunInfo(buf, failures, info)
+			if err != nil {
+				t.Errorf("got err %v, but want nil", err)
+				return
+			}
+			if buf.String() != test.wantOutput {
+				t.Errorf("got %s, but want %s", buf.String(), test.wantOutput)
+			}
+		})
+	}
+}
+
+func TestNewTemplateReporter(t *testing.T) {
+	dir := t.TempDir()
+	invalidPath := filepath.Join(dir, "invalid.tmpl")
+	if err := os.WriteFile(invalidPath, []byte(`{{range .Failures}}`), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	tests := []struct {
+		name      string
+		inputPath string
+	}{
+		{
+			name:      "Fails with the missing file",
+			inputPath: filepath.Join(dir, "missing.tmpl"),
+		},
+		{
+			name:      "Fails with the invalid template",
+			inputPath: invalidPath,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			_, err := reporters.NewTemplateReporter(test.inputPath)
+			if err == nil {
+				t.Errorf("got nil, but want err")
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 // TscRport prints failures as string compatible to Type script compiler
 //
 // The format is "FILENAME(LINE,COL): SEVERITY RULE_ID: MESSAGE".
+// The location of the failure with a range is "(LINE,COL,END_LINE,END_COL)".
 type TscReporter struct{}
 
 func getTscSeverity(s string) string {
@@ -24,11 +25,14 @@ func getTscSeverity(s string) string {
 // Report writes failures to w.
 func (r TscReporter) Report(w io.Writer, fs []report.Failure) error {
 	for _, failure := range fs {
+		location := fmt.Sprintf("%d,%d", failure.Pos().Line, failure.Pos().Column)
+		if failure.HasRange() {
+			location += fmt.Sprintf(",%d,%d", failure.End().Line, failure.End().Column)
+		}
 		tsc_output := fmt.Sprintf(
-			"%s(%d,%d): %s %s: '%s'",
+			"%s(%s): %s %s: '%s'",
 			failure.Pos().Filename,
-			failure.Pos().Line,
-			failure.Pos().Column,
+			location,
 			getTscSeverity(failure.Severity()),
 			failure.RuleID(),
 			strings.Trim(failure.Message(), `"`),
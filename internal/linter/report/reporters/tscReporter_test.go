@@ -46,6 +46,30 @@ func TestTscReporter_Report(t *testing.T) {
 			},
 			wantOutput: `example.proto(5,10): error ENUM_NAMES_UPPER_CAMEL_CASE: 'EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES'
 example.proto(10,20): warning ENUM_NAMES_UPPER_CAMEL_CASE: 'EnumField name "SECOND.VALUE" must be CAPITALS_WITH_UNDERSCORES'
+`,
+		},
+		{
+			name: "Prints failures with the ranges",
+			inputFailures: []report.Failure{
+				report.FailureWithRangef(
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   100,
+						Line:     5,
+						Column:   10,
+					},
+					meta.Position{
+						Filename: "example.proto",
+						Offset:   125,
+						Line:     5,
+						Column:   35,
+					},
+					"ENUM_NAMES_UPPER_CAMEL_CASE",
+					string(rule.SeverityError),
+					`EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES`,
+				),
+			},
+			wantOutput: `example.proto(5,10,5,35): error ENUM_NAMES_UPPER_CAMEL_CASE: 'EnumField name "fIRST_VALUE" must be CAPITALS_WITH_UNDERSCORES'
 `,
 		},
 	}
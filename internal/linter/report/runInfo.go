@@ -0,0 +1,96 @@
+package report
+
+import (
+	"io"
+	"time"
+
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// RuleDescriptor describes a rule applied in the run.
+type RuleDescriptor struct {
+	ID       string
+	Purpose  string
+	Severity rule.Severity
+	// HelpURI is empty if the rule has no documentation, like a plugin rule.
+	HelpURI string
+	Fixable bool
+}
+
+// RunInfo represents the information of the run other than the failures.
+type RunInfo struct {
+	// Rules describes the applied rules, sorted by the ID.
+	Rules []RuleDescriptor
+	// Suppressed is the failures disabled by the protolint:disable comments.
+	Suppressed []report.Failure
+	// Fixes is the replacements which the -fix option makes per failure.
+	Fixes []Fix
+	// ConfigPath is empty if no config file is loaded.
+	ConfigPath string
+	ExitCode   osutil.ExitCode
+	Stats      Stats
+}
+
+// Stats represents the statistics of the run.
+type Stats struct {
+	FilesLinted int
+	// FilesSkipped is the number of the files to which no rule applies.
+	FilesSkipped int
+	// FixesApplied is the number of the replacements applied by the -fix option.
+	FixesApplied int
+	// RuleElapsed is the time spent applying each rule, keyed by the rule ID.
+	RuleElapsed map[string]time.Duration
+}
+
+// Rule returns the descriptor of the rule.
+func (i RunInfo) Rule(ruleID string) (RuleDescriptor, bool) {
+	for _, r := range i.Rules {
+		if r.ID == ruleID {
+			return r, true
+		}
+	}
+	return RuleDescriptor{}, false
+}
+
+// FixesFor returns the replacements to fix the failure.
+// It returns nil if the failure isn't fixable.
+func (i RunInfo) FixesFor(f report.Failure) []Replacement {
+	var replacements []Replacement
+	for _, fix := range i.Fixes {
+		if fix.fixes(f) {
+			replacements = append(replacements, fix.Replacements...)
+		}
+	}
+	return replacements
+}
+
+// Fix represents the replacements which the -fix option makes to fix the failure.
+//
+// The replacements can be far from the failure, like moving an import.
+type Fix struct {
+	Failure      report.Failure
+	Replacements []Replacement
+}
+
+// fixes reports whether the fix is for the failure. The severity is ignored
+// because it can be overridden after the rule reports the failure.
+func (fix Fix) fixes(f report.Failure) bool {
+	return fix.Failure.Pos() == f.Pos() &&
+		fix.Failure.RuleID() == f.RuleID() &&
+		fix.Failure.Message() == f.Message()
+}
+
+// RunInfoReporter is a Reporter which also reports the information of the run.
+type RunInfoReporter interface {
+	Reporter
+	ReportWithRunInfo(io.Writer, []report.Failure, RunInfo) error
+}
+
+// RulesOnlyReporter is a RunInfoReporter which uses only the rules and the exit code of the run information.
+// The suppressed failures and the fixes are not collected for it, because they take extra work while linting.
+type RulesOnlyReporter interface {
+	RunInfoReporter
+	UsesOnlyRules() bool
+}
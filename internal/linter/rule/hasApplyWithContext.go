@@ -0,0 +1,29 @@
+package rule
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// HasApplyWithContext represents a rule which looks into the files imported by the proto.
+// The linter calls ApplyWithContext instead of Apply when it resolves the imports.
+type HasApplyWithContext interface {
+	ApplyWithContext(ctx *symbol.Context, proto *parser.Proto) ([]report.Failure, error)
+}
+
+// NeedsContext reports whether the rule, or the rule wrapped by it, implements HasApplyWithContext.
+func NeedsContext(r rule.HasApply) bool {
+	for {
+		if _, ok := r.(HasApplyWithContext); !ok {
+			return false
+		}
+		unwrapper, ok := r.(interface{ Unwrap() rule.Rule })
+		if !ok {
+			return true
+		}
+		r = unwrapper.Unwrap()
+	}
+}
@@ -0,0 +1,74 @@
+package rule
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/rule"
+)
+
+// SeverityOverriddenRule reports the failures of the rule with another severity.
+type SeverityOverriddenRule struct {
+	rule.Rule
+	severity rule.Severity
+}
+
+// NewSeverityOverriddenRule creates a new SeverityOverriddenRule.
+func NewSeverityOverriddenRule(
+	r rule.Rule,
+	severity rule.Severity,
+) SeverityOverriddenRule {
+	return SeverityOverriddenRule{
+		Rule:     r,
+		severity: severity,
+	}
+}
+
+// Severity gets the overridden severity of the rule.
+func (r SeverityOverriddenRule) Severity() rule.Severity {
+	return r.severity
+}
+
+// IsFixable decides whether or not the inner rule can fix the failures.
+func (r SeverityOverriddenRule) IsFixable() bool {
+	fixable, ok := r.Rule.(rule.HasIsFixable)
+	return ok && fixable.IsFixable()
+}
+
+// Unwrap returns the inner rule.
+func (r SeverityOverriddenRule) Unwrap() rule.Rule {
+	return r.Rule
+}
+
+// Apply applies the rule to the proto and overrides the severity of the failures.
+func (r SeverityOverriddenRule) Apply(proto *parser.Proto) ([]report.Failure, error) {
+	failures, err := r.Rule.Apply(proto)
+	if err != nil {
+		return nil, err
+	}
+	return r.override(failures), nil
+}
+
+// ApplyWithContext applies the rule to the proto with the context if the inner rule takes it,
+// and overrides the severity of the failures.
+func (r SeverityOverriddenRule) ApplyWithContext(ctx *symbol.Context, proto *parser.Proto) ([]report.Failure, error) {
+	inner, ok := r.Rule.(HasApplyWithContext)
+	if !ok {
+		return r.Apply(proto)
+	}
+
+	failures, err := inner.ApplyWithContext(ctx, proto)
+	if err != nil {
+		return nil, err
+	}
+	return r.override(failures), nil
+}
+
+func (r SeverityOverriddenRule) override(failures []report.Failure) []report.Failure {
+	overridden := make([]report.Failure, 0, len(failures))
+	for _, f := range failures {
+		overridden = append(overridden, report.FailureWithRangef(f.Pos(), f.End(), f.RuleID(), string(r.severity), "%s", f.Message()))
+	}
+	return overridden
+}
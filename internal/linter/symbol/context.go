@@ -0,0 +1,72 @@
+package symbol
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// ResolvedImport is an import statement and the file it imports.
+type ResolvedImport struct {
+	Import *parser.Import
+	// File is the imported file, or nil if Err isn't nil.
+	File *ImportedFile
+	Err  error
+}
+
+// Context is what a rule can see beyond the proto: the files it imports and the types visible from it.
+type Context struct {
+	// ImportPath is the path under which the other files import the proto,
+	// or "" if no import path contains it.
+	ImportPath string
+	// Imports are the imports of the proto in order.
+	Imports []ResolvedImport
+	// Table is the table of the types visible from the proto.
+	Table Table
+	// Extensions is the table of the extensions visible from the proto.
+	Extensions Table
+	// Graph is the import graph over the files linted so far and the files they import.
+	Graph *Graph
+	// Importer is the importer which resolved the imports.
+	Importer *Importer
+}
+
+// HasUnresolvedImports reports whether any import of the proto can't be resolved.
+func (c *Context) HasUnresolvedImports() bool {
+	for _, imp := range c.Imports {
+		if imp.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Context resolves the imports of the proto and adds the proto to the import graph.
+// The proto is named after proto.Meta.Filename in the graph unless an import path contains it.
+func (i *Importer) Context(proto *parser.Proto) *Context {
+	importPath, _ := i.ImportPathOf(proto.Meta.Filename)
+
+	var imports []ResolvedImport
+	for _, imp := range Imports(proto) {
+		f, err := i.Import(ImportPath(imp))
+		imports = append(imports, ResolvedImport{Import: imp, File: f, Err: err})
+	}
+	table, extensions, _ := i.tables(proto)
+
+	node := importPath
+	if node == "" {
+		node = proto.Meta.Filename
+	}
+	i.graph.Add(node, proto)
+	// Keep the latest one, which the previous rules may have fixed.
+	if _, ok := i.lintedProtos[proto.Meta.Filename]; ok {
+		i.lintedProtos[proto.Meta.Filename] = proto
+	}
+
+	return &Context{
+		ImportPath: importPath,
+		Imports:    imports,
+		Table:      table,
+		Extensions: extensions,
+		Graph:      i.graph,
+		Importer:   i,
+	}
+}
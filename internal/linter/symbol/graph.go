@@ -0,0 +1,90 @@
+package symbol
+
+import (
+	"sort"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+)
+
+// Graph is the import graph of the files, whose nodes are the import paths of the files.
+// The files found through the imports are added transitively.
+type Graph struct {
+	importer *Importer
+	imports  map[string][]string
+}
+
+func newGraph(importer *Importer) *Graph {
+	return &Graph{
+		importer: importer,
+		imports:  make(map[string][]string),
+	}
+}
+
+// Add adds the proto named node, replacing the imports it had, and the files it imports transitively.
+func (g *Graph) Add(node string, proto *parser.Proto) {
+	var imports []string
+	for _, imp := range Imports(proto) {
+		imports = append(imports, ImportPath(imp))
+	}
+	g.imports[node] = imports
+
+	for _, importPath := range imports {
+		g.addImported(importPath)
+	}
+}
+
+func (g *Graph) addImported(importPath string) {
+	if _, ok := g.imports[importPath]; ok {
+		return
+	}
+
+	f, err := g.importer.Import(importPath)
+	if err != nil {
+		// Keep the node without any import not to try again.
+		g.imports[importPath] = nil
+		return
+	}
+	g.Add(importPath, f.Proto)
+}
+
+// Imports returns the import paths which the node imports in order.
+func (g *Graph) Imports(node string) []string {
+	return g.imports[node]
+}
+
+// Path returns the shortest path of the imports from the node to the other one, including both ends.
+// It returns nil if the node doesn't lead to the other one.
+func (g *Graph) Path(from, to string) []string {
+	prev := map[string]string{from: ""}
+	queue := []string{from}
+	for 0 < len(queue) {
+		node := queue[0]
+		queue = queue[1:]
+		if node == to {
+			var path []string
+			for n := to; n != ""; n = prev[n] {
+				path = append([]string{n}, path...)
+			}
+			return path
+		}
+
+		for _, next := range g.imports[node] {
+			if _, ok := prev[next]; ok {
+				continue
+			}
+			prev[next] = node
+			queue = append(queue, next)
+		}
+	}
+	return nil
+}
+
+// Nodes returns the nodes in the graph in sorted order.
+func (g *Graph) Nodes() []string {
+	var nodes []string
+	for node := range g.imports {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
@@ -0,0 +1,236 @@
+package symbol
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	protoparser "github.com/yoheimuta/go-protoparser/v4"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+)
+
+// ErrImportNotFound is returned when an imported file isn't found under any import path.
+var ErrImportNotFound = errors.New("import not found")
+
+// ImportedFile is a file found under an import path.
+type ImportedFile struct {
+	// Path is the path of the file, which is the import path joined to the root where it is found.
+	// It is the import path itself for a well-known file bundled with protoc and not found under any root.
+	Path  string
+	Proto *parser.Proto
+}
+
+// ImportError is an import which can't be resolved.
+type ImportError struct {
+	Import *parser.Import
+	Err    error
+}
+
+type importResult struct {
+	file *ImportedFile
+	err  error
+}
+
+// DefaultImportPaths are the import paths used when none is given. protoc also searches the current directory.
+var DefaultImportPaths = []string{"."}
+
+// Importer finds the imported files under the import paths, like protoc -I, and parses them.
+// It caches the parsed files, so it is meant to be used while the files don't change.
+// It isn't safe for concurrent use.
+type Importer struct {
+	importPaths []string
+	results     map[string]importResult
+	graph       *Graph
+	linted      []file.ProtoFile
+	// lintedProtos are the parsed linted files keyed by the display paths. nil means a parse error.
+	lintedProtos map[string]*parser.Proto
+}
+
+// NewImporter creates a new Importer which searches importPaths in order.
+func NewImporter(
+	importPaths []string,
+) *Importer {
+	i := &Importer{
+		importPaths:  importPaths,
+		results:      make(map[string]importResult),
+		lintedProtos: make(map[string]*parser.Proto),
+	}
+	i.graph = newGraph(i)
+	return i
+}
+
+// ImportPaths returns the import paths.
+func (i *Importer) ImportPaths() []string {
+	return i.importPaths
+}
+
+// Import finds the file imported as importPath and parses it.
+// It returns an error wrapping ErrImportNotFound if no import path has the file.
+func (i *Importer) Import(importPath string) (*ImportedFile, error) {
+	if result, ok := i.results[importPath]; ok {
+		return result.file, result.err
+	}
+
+	f, err := i.importFile(importPath)
+	i.results[importPath] = importResult{file: f, err: err}
+	return f, err
+}
+
+func (i *Importer) importFile(importPath string) (*ImportedFile, error) {
+	for _, root := range i.importPaths {
+		path := filepath.Join(root, filepath.FromSlash(importPath))
+		if _, err := os.Stat(path); os.IsNotExist(err) {
+			continue
+		}
+
+		proto, err := file.NewProtoFile(path, path).Parse(false)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", path, err)
+		}
+		return &ImportedFile{
+			Path:  path,
+			Proto: proto,
+		}, nil
+	}
+
+	if src, ok := wellKnownFiles[importPath]; ok {
+		proto, err := protoparser.Parse(strings.NewReader(src), protoparser.WithFilename(importPath))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %v", importPath, err)
+		}
+		return &ImportedFile{
+			Path:  importPath,
+			Proto: proto,
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %q in the import paths %v", ErrImportNotFound, importPath, i.importPaths)
+}
+
+// SetLintedFiles sets the files linted together, which the rules look into across the files.
+func (i *Importer) SetLintedFiles(files []file.ProtoFile) {
+	i.linted = files
+}
+
+// LintedFiles returns the files linted together. Their paths are the display paths.
+// The files which can't be parsed are left out, because their own linting reports the errors.
+func (i *Importer) LintedFiles() []*ImportedFile {
+	var files []*ImportedFile
+	for _, f := range i.linted {
+		proto, ok := i.lintedProtos[f.DisplayPath()]
+		if !ok {
+			proto, _ = f.Parse(false)
+			i.lintedProtos[f.DisplayPath()] = proto
+		}
+		if proto == nil {
+			continue
+		}
+		files = append(files, &ImportedFile{
+			Path:  f.DisplayPath(),
+			Proto: proto,
+		})
+	}
+	return files
+}
+
+// ImportPathOf returns the import path of the file at path, which is the path relative to the first import path
+// containing the file. It returns false if no import path contains the file.
+func (i *Importer) ImportPathOf(path string) (string, bool) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return "", false
+	}
+	for _, root := range i.importPaths {
+		absRoot, err := filepath.Abs(root)
+		if err != nil {
+			continue
+		}
+		rel, err := filepath.Rel(absRoot, abs)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return filepath.ToSlash(rel), true
+	}
+	return "", false
+}
+
+// Table returns the table of the types visible from the proto: its own types, the types in the files it imports,
+// and the types in the files which they import publicly, transitively.
+// It also returns the imports of the proto which can't be imported.
+func (i *Importer) Table(proto *parser.Proto) (Table, []ImportError) {
+	types, _, errs := i.tables(proto)
+	return types, errs
+}
+
+// tables returns the tables of the types and the extensions visible from the proto.
+func (i *Importer) tables(proto *parser.Proto) (Table, Table, []ImportError) {
+	types := make(Table)
+	types.Add(Definitions(proto))
+	extensions := make(Table)
+	extensions.Add(Extensions(proto))
+
+	var errs []ImportError
+	visited := make(map[string]bool)
+	for _, imp := range Imports(proto) {
+		f, err := i.Import(ImportPath(imp))
+		if err != nil {
+			errs = append(errs, ImportError{Import: imp, Err: err})
+			continue
+		}
+		for _, pf := range i.PublicClosure(ImportPath(imp), f) {
+			if visited[pf.Path] {
+				continue
+			}
+			visited[pf.Path] = true
+			types.Add(Definitions(pf.Proto))
+			extensions.Add(Extensions(pf.Proto))
+		}
+	}
+	return types, extensions, errs
+}
+
+// PublicClosure returns f imported as importPath and the files which f imports publicly, transitively.
+// The errors in the public imports are left to the linting of f.
+func (i *Importer) PublicClosure(importPath string, f *ImportedFile) []*ImportedFile {
+	var files []*ImportedFile
+	visited := make(map[string]bool)
+
+	var walk func(string, *ImportedFile)
+	walk = func(importPath string, f *ImportedFile) {
+		if visited[importPath] {
+			return
+		}
+		visited[importPath] = true
+		files = append(files, f)
+
+		for _, imp := range Imports(f.Proto) {
+			if imp.Modifier != parser.ImportModifierPublic {
+				continue
+			}
+			if pf, err := i.Import(ImportPath(imp)); err == nil {
+				walk(ImportPath(imp), pf)
+			}
+		}
+	}
+	walk(importPath, f)
+	return files
+}
+
+// ImportPath returns the path imported by the statement without the quotes.
+func ImportPath(imp *parser.Import) string {
+	return strings.Trim(imp.Location, `"'`)
+}
+
+// Imports returns the import statements of the proto.
+func Imports(proto *parser.Proto) []*parser.Import {
+	var imports []*parser.Import
+	for _, body := range proto.ProtoBody {
+		if imp, ok := body.(*parser.Import); ok {
+			imports = append(imports, imp)
+		}
+	}
+	return imports
+}
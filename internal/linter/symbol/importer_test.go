@@ -0,0 +1,181 @@
+package symbol_test
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+)
+
+func TestImporter_Import(t *testing.T) {
+	root := setting_test.TestDataPath("imports")
+	importer := symbol.NewImporter([]string{
+		setting_test.TestDataPath("testdir"),
+		root,
+	})
+
+	got, err := importer.Import("bar/v1/bar.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := filepath.Join(root, "bar", "v1", "bar.proto"); got.Path != want {
+		t.Errorf("got %s, but want %s", got.Path, want)
+	}
+	if symbol.PackageName(got.Proto) != "bar.v1" {
+		t.Errorf("got package %s, but want bar.v1", symbol.PackageName(got.Proto))
+	}
+
+	_, err = importer.Import("missing/v1/missing.proto")
+	if !errors.Is(err, symbol.ErrImportNotFound) {
+		t.Errorf("got %v, but want ErrImportNotFound", err)
+	}
+
+	wkt, err := importer.Import("google/protobuf/timestamp.proto")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wkt.Path != "google/protobuf/timestamp.proto" {
+		t.Errorf("got %s, but want google/protobuf/timestamp.proto", wkt.Path)
+	}
+	if defs := symbol.Definitions(wkt.Proto); len(defs) != 1 || defs[0].FullName != "google.protobuf.Timestamp" {
+		t.Errorf("got %v, but want google.protobuf.Timestamp", defs)
+	}
+}
+
+func TestImporter_ImportPathOf(t *testing.T) {
+	root := setting_test.TestDataPath("imports")
+	importer := symbol.NewImporter([]string{
+		setting_test.TestDataPath("testdir"),
+		root,
+	})
+
+	tests := []struct {
+		name           string
+		path           string
+		wantImportPath string
+		wantOK         bool
+	}{
+		{
+			name:           "file under the second import path",
+			path:           filepath.Join(root, "bar", "v1", "bar.proto"),
+			wantImportPath: "bar/v1/bar.proto",
+			wantOK:         true,
+		},
+		{
+			name: "file outside the import paths",
+			path: setting_test.TestDataPath("rules", "a.proto"),
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := importer.ImportPathOf(test.path)
+			if ok != test.wantOK {
+				t.Fatalf("got ok %v, but want %v", ok, test.wantOK)
+			}
+			if got != test.wantImportPath {
+				t.Errorf("got %s, but want %s", got, test.wantImportPath)
+			}
+		})
+	}
+}
+
+func TestImporter_Context(t *testing.T) {
+	root := setting_test.TestDataPath("imports")
+	path := filepath.Join(root, "foo", "v1", "foo.proto")
+	proto, err := file.NewProtoFile(path, path).Parse(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := symbol.NewImporter([]string{root}).Context(proto)
+
+	if ctx.ImportPath != "foo/v1/foo.proto" {
+		t.Errorf("got %s, but want foo/v1/foo.proto", ctx.ImportPath)
+	}
+	if len(ctx.Imports) != 2 || ctx.Imports[0].File == nil || ctx.Imports[1].Err == nil {
+		t.Errorf("got %v, but want bar.proto resolved and missing.proto not", ctx.Imports)
+	}
+	if !ctx.HasUnresolvedImports() {
+		t.Error("got false, but want true")
+	}
+	if _, ok := ctx.Table.Resolve("foo.v1", "bar.v1.Bar"); !ok {
+		t.Error("bar.v1.Bar is not resolved")
+	}
+
+	wantNodes := []string{
+		"bar/v1/bar.proto",
+		"foo/v1/foo.proto",
+		"missing/v1/missing.proto",
+		"qux/v1/qux.proto",
+	}
+	if got := ctx.Graph.Nodes(); !reflect.DeepEqual(got, wantNodes) {
+		t.Errorf("got %v, but want %v", got, wantNodes)
+	}
+	wantImports := []string{"bar/v1/bar.proto", "missing/v1/missing.proto"}
+	if got := ctx.Graph.Imports("foo/v1/foo.proto"); !reflect.DeepEqual(got, wantImports) {
+		t.Errorf("got %v, but want %v", got, wantImports)
+	}
+	if got := ctx.Graph.Imports("bar/v1/bar.proto"); !reflect.DeepEqual(got, []string{"qux/v1/qux.proto"}) {
+		t.Errorf("got %v, but want [qux/v1/qux.proto]", got)
+	}
+
+	wantPath := []string{"foo/v1/foo.proto", "bar/v1/bar.proto", "qux/v1/qux.proto"}
+	if got := ctx.Graph.Path("foo/v1/foo.proto", "qux/v1/qux.proto"); !reflect.DeepEqual(got, wantPath) {
+		t.Errorf("got %v, but want %v", got, wantPath)
+	}
+	if got := ctx.Graph.Path("qux/v1/qux.proto", "foo/v1/foo.proto"); got != nil {
+		t.Errorf("got %v, but want nil", got)
+	}
+}
+
+func TestImporter_Table(t *testing.T) {
+	root := setting_test.TestDataPath("imports")
+	path := filepath.Join(root, "foo", "v1", "foo.proto")
+	proto, err := file.NewProtoFile(path, path).Parse(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table, errs := symbol.NewImporter([]string{root}).Table(proto)
+
+	if len(errs) != 1 || symbol.ImportPath(errs[0].Import) != "missing/v1/missing.proto" ||
+		!errors.Is(errs[0].Err, symbol.ErrImportNotFound) {
+		t.Errorf("got %v, but want the error of missing/v1/missing.proto", errs)
+	}
+
+	for _, ref := range symbol.References(proto) {
+		if _, ok := table.Resolve(ref.Scope, ref.Name); !ok {
+			t.Errorf("%s in %s is not resolved", ref.Name, ref.Scope)
+		}
+	}
+
+	def, ok := table.Resolve("foo.v1", "qux.v1.Qux")
+	if !ok {
+		t.Fatal("qux.v1.Qux imported publicly by bar.proto is not resolved")
+	}
+	if want := filepath.Join(root, "qux", "v1", "qux.proto"); def.Pos.Filename != want || def.Pos.Line != 5 {
+		t.Errorf("got %s:%d, but want %s:5", def.Pos.Filename, def.Pos.Line, want)
+	}
+}
+
+func TestImporter_LintedFiles(t *testing.T) {
+	path := setting_test.TestDataPath("imports", "bar", "v1", "bar.proto")
+	invalidPath := setting_test.TestDataPath("imports", "not_found.proto")
+
+	importer := symbol.NewImporter(nil)
+	importer.SetLintedFiles([]file.ProtoFile{
+		file.NewProtoFile(path, "bar.proto"),
+		file.NewProtoFile(invalidPath, invalidPath),
+	})
+
+	got := importer.LintedFiles()
+	if len(got) != 1 || got[0].Path != "bar.proto" || symbol.PackageName(got[0].Proto) != "bar.v1" {
+		t.Errorf("got %v, but want only bar.proto", got)
+	}
+}
@@ -0,0 +1,279 @@
+// Package symbol collects the message and enum types and the extensions defined and referenced in
+// Protocol Buffer files, and resolves the references across the imported files.
+package symbol
+
+import (
+	"strings"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+// Kind is the kind of a defined type.
+type Kind string
+
+// Kinds of the defined types.
+const (
+	KindMessage   Kind = "message"
+	KindEnum      Kind = "enum"
+	KindExtension Kind = "extension"
+)
+
+// Definition is a message or an enum type, or an extension field, defined in a file.
+type Definition struct {
+	// FullName is the fully-qualified name without the leading dot, like foo.bar.Outer.Inner.
+	FullName string
+	Kind     Kind
+	// Pos is the position of the definition. Pos.Filename is the one given to the parser.
+	Pos meta.Position
+}
+
+// Reference is a type referenced by a field, an RPC or an extend, or an extension referenced by a custom option.
+type Reference struct {
+	// Name is the name as written, like Inner, foo.bar.Outer or .foo.bar.Outer.
+	Name string
+	// Scope is the full name of the message where the name is referenced, or the package name.
+	Scope string
+	// Pos is the position of the element which references the type.
+	Pos meta.Position
+}
+
+// scalarTypes are the types which aren't defined by any file.
+var scalarTypes = map[string]bool{
+	"double":   true,
+	"float":    true,
+	"int32":    true,
+	"int64":    true,
+	"uint32":   true,
+	"uint64":   true,
+	"sint32":   true,
+	"sint64":   true,
+	"fixed32":  true,
+	"fixed64":  true,
+	"sfixed32": true,
+	"sfixed64": true,
+	"bool":     true,
+	"string":   true,
+	"bytes":    true,
+}
+
+// PackageName returns the package name of the proto, or "" if it has no package statement.
+func PackageName(proto *parser.Proto) string {
+	for _, body := range proto.ProtoBody {
+		if p, ok := body.(*parser.Package); ok {
+			return p.Name
+		}
+	}
+	return ""
+}
+
+// Definitions returns the message and enum types defined in the proto, including the nested ones and the groups.
+func Definitions(proto *parser.Proto) []Definition {
+	return collectDefinitions(PackageName(proto), proto.ProtoBody)
+}
+
+func collectDefinitions(scope string, bodies []parser.Visitee) []Definition {
+	var defs []Definition
+	for _, body := range bodies {
+		switch b := body.(type) {
+		case *parser.Message:
+			fullName := qualify(scope, b.MessageName)
+			defs = append(defs, Definition{FullName: fullName, Kind: KindMessage, Pos: b.Meta.Pos})
+			defs = append(defs, collectDefinitions(fullName, b.MessageBody)...)
+		case *parser.GroupField:
+			fullName := qualify(scope, b.GroupName)
+			defs = append(defs, Definition{FullName: fullName, Kind: KindMessage, Pos: b.Meta.Pos})
+			defs = append(defs, collectDefinitions(fullName, b.MessageBody)...)
+		case *parser.Enum:
+			defs = append(defs, Definition{FullName: qualify(scope, b.EnumName), Kind: KindEnum, Pos: b.Meta.Pos})
+		case *parser.Extend:
+			// The groups in an extend are defined in the enclosing scope.
+			defs = append(defs, collectDefinitions(scope, b.ExtendBody)...)
+		}
+	}
+	return defs
+}
+
+// References returns the message and enum types referenced in the proto, excluding the scalar types.
+func References(proto *parser.Proto) []Reference {
+	return collectReferences(PackageName(proto), proto.ProtoBody)
+}
+
+func collectReferences(scope string, bodies []parser.Visitee) []Reference {
+	var refs []Reference
+	add := func(name string, pos meta.Position) {
+		if !scalarTypes[name] {
+			refs = append(refs, Reference{Name: name, Scope: scope, Pos: pos})
+		}
+	}
+
+	for _, body := range bodies {
+		switch b := body.(type) {
+		case *parser.Message:
+			refs = append(refs, collectReferences(qualify(scope, b.MessageName), b.MessageBody)...)
+		case *parser.GroupField:
+			refs = append(refs, collectReferences(qualify(scope, b.GroupName), b.MessageBody)...)
+		case *parser.Field:
+			add(b.Type, b.Meta.Pos)
+		case *parser.MapField:
+			add(b.Type, b.Meta.Pos)
+		case *parser.Oneof:
+			for _, field := range b.OneofFields {
+				add(field.Type, field.Meta.Pos)
+			}
+		case *parser.Extend:
+			add(b.MessageType, b.Meta.Pos)
+			refs = append(refs, collectReferences(scope, b.ExtendBody)...)
+		case *parser.Service:
+			for _, sb := range b.ServiceBody {
+				if rpc, ok := sb.(*parser.RPC); ok {
+					add(rpc.RPCRequest.MessageType, rpc.RPCRequest.Meta.Pos)
+					add(rpc.RPCResponse.MessageType, rpc.RPCResponse.Meta.Pos)
+				}
+			}
+		}
+	}
+	return refs
+}
+
+// Extensions returns the extension fields defined in the proto, including the ones in the messages.
+func Extensions(proto *parser.Proto) []Definition {
+	return collectExtensions(PackageName(proto), proto.ProtoBody)
+}
+
+func collectExtensions(scope string, bodies []parser.Visitee) []Definition {
+	var defs []Definition
+	for _, body := range bodies {
+		switch b := body.(type) {
+		case *parser.Message:
+			defs = append(defs, collectExtensions(qualify(scope, b.MessageName), b.MessageBody)...)
+		case *parser.Extend:
+			for _, eb := range b.ExtendBody {
+				switch f := eb.(type) {
+				case *parser.Field:
+					defs = append(defs, Definition{FullName: qualify(scope, f.FieldName), Kind: KindExtension, Pos: f.Meta.Pos})
+				case *parser.GroupField:
+					// The field of a group is named after the lowercased group name.
+					defs = append(defs, Definition{FullName: qualify(scope, strings.ToLower(f.GroupName)), Kind: KindExtension, Pos: f.Meta.Pos})
+				}
+			}
+		}
+	}
+	return defs
+}
+
+// OptionReferences returns the extensions referenced by the custom options in the proto,
+// like foo.bar in option (foo.bar).baz = true.
+func OptionReferences(proto *parser.Proto) []Reference {
+	return collectOptionReferences(PackageName(proto), proto.ProtoBody)
+}
+
+func collectOptionReferences(scope string, bodies []parser.Visitee) []Reference {
+	var refs []Reference
+	add := func(optionName string, pos meta.Position) {
+		for _, name := range extensionNames(optionName) {
+			refs = append(refs, Reference{Name: name, Scope: scope, Pos: pos})
+		}
+	}
+	addFieldOptions := func(options []*parser.FieldOption, pos meta.Position) {
+		for _, option := range options {
+			add(option.OptionName, pos)
+		}
+	}
+
+	for _, body := range bodies {
+		switch b := body.(type) {
+		case *parser.Option:
+			add(b.OptionName, b.Meta.Pos)
+		case *parser.Message:
+			refs = append(refs, collectOptionReferences(qualify(scope, b.MessageName), b.MessageBody)...)
+		case *parser.GroupField:
+			refs = append(refs, collectOptionReferences(qualify(scope, b.GroupName), b.MessageBody)...)
+		case *parser.Field:
+			addFieldOptions(b.FieldOptions, b.Meta.Pos)
+		case *parser.MapField:
+			addFieldOptions(b.FieldOptions, b.Meta.Pos)
+		case *parser.Oneof:
+			for _, option := range b.Options {
+				add(option.OptionName, option.Meta.Pos)
+			}
+			for _, field := range b.OneofFields {
+				addFieldOptions(field.FieldOptions, field.Meta.Pos)
+			}
+		case *parser.Enum:
+			refs = append(refs, collectOptionReferences(qualify(scope, b.EnumName), b.EnumBody)...)
+		case *parser.EnumField:
+			for _, option := range b.EnumValueOptions {
+				add(option.OptionName, b.Meta.Pos)
+			}
+		case *parser.Extend:
+			refs = append(refs, collectOptionReferences(scope, b.ExtendBody)...)
+		case *parser.Service:
+			refs = append(refs, collectOptionReferences(qualify(scope, b.ServiceName), b.ServiceBody)...)
+		case *parser.RPC:
+			for _, option := range b.Options {
+				add(option.OptionName, option.Meta.Pos)
+			}
+		}
+	}
+	return refs
+}
+
+// extensionNames returns the names in the parentheses of the option name, like foo.bar in (foo.bar).baz.
+func extensionNames(optionName string) []string {
+	var names []string
+	for {
+		start := strings.Index(optionName, "(")
+		if start < 0 {
+			return names
+		}
+		end := strings.Index(optionName[start:], ")")
+		if end < 0 {
+			return names
+		}
+		names = append(names, optionName[start+1:start+end])
+		optionName = optionName[start+end+1:]
+	}
+}
+
+func qualify(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// Table indexes the definitions by their full names.
+type Table map[string]Definition
+
+// Add adds the definitions to the table. The first definition of a name wins.
+func (t Table) Add(defs []Definition) {
+	for _, def := range defs {
+		if _, ok := t[def.FullName]; !ok {
+			t[def.FullName] = def
+		}
+	}
+}
+
+// Resolve finds the definition of the type name referenced in scope, following the scoping rules of protobuf:
+// the name is searched for from the innermost scope outward, unless it starts with a dot.
+func (t Table) Resolve(scope, name string) (Definition, bool) {
+	if strings.HasPrefix(name, ".") {
+		def, ok := t[name[1:]]
+		return def, ok
+	}
+
+	for {
+		if def, ok := t[qualify(scope, name)]; ok {
+			return def, true
+		}
+		if scope == "" {
+			return Definition{}, false
+		}
+		if i := strings.LastIndex(scope, "."); 0 <= i {
+			scope = scope[:i]
+		} else {
+			scope = ""
+		}
+	}
+}
@@ -0,0 +1,224 @@
+package symbol_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	protoparser "github.com/yoheimuta/go-protoparser/v4"
+
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+)
+
+func TestDefinitions(t *testing.T) {
+	path := setting_test.TestDataPath("imports", "foo", "v1", "foo.proto")
+	proto, err := file.NewProtoFile(path, path).Parse(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, def := range symbol.Definitions(proto) {
+		got = append(got, string(def.Kind)+" "+def.FullName)
+	}
+	want := []string{
+		"message foo.v1.Foo",
+		"message foo.v1.Foo.Inner",
+		"enum foo.v1.Foo.Kind",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
+
+func TestReferences(t *testing.T) {
+	path := setting_test.TestDataPath("imports", "foo", "v1", "foo.proto")
+	proto, err := file.NewProtoFile(path, path).Parse(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, ref := range symbol.References(proto) {
+		got = append(got, ref.Scope+" "+ref.Name)
+	}
+	want := []string{
+		"foo.v1.Foo.Inner Kind",
+		"foo.v1.Foo Inner",
+		"foo.v1.Foo bar.v1.Bar",
+		"foo.v1.Foo .bar.v1.Bar.Nested",
+		"foo.v1.Foo qux.v1.Qux",
+		"foo.v1.Foo Kind",
+		"foo.v1 Foo",
+		"foo.v1 Foo",
+		"foo.v1 Foo",
+		"foo.v1 bar.v1.Bar",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
+
+const optionsProto = `syntax = "proto3";
+package foo.v1;
+option (file_opt) = true;
+message Foo {
+  option (.foo.v1.message_opt).name = "foo";
+  extend google.protobuf.FieldOptions {
+    string label = 50000;
+  }
+  string name = 1 [deprecated = true, (Foo.label) = "name"];
+  map<string, string> tags = 2 [(ext.tag) = true];
+  oneof value {
+    option (oneof_opt) = true;
+    int64 number = 3 [(a).(b.c) = 1];
+  }
+}
+enum Kind {
+  option (enum_opt) = true;
+  KIND_UNSPECIFIED = 0 [(value_opt) = true];
+}
+service FooService {
+  option (service_opt) = true;
+  rpc GetFoo(Foo) returns (Foo) {
+    option (google.api.http) = { get: "/v1/foo" };
+  }
+}
+extend google.protobuf.FileOptions {
+  bool file_opt = 50000;
+}
+`
+
+func TestExtensions(t *testing.T) {
+	proto, err := protoparser.Parse(strings.NewReader(optionsProto))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, def := range symbol.Extensions(proto) {
+		got = append(got, string(def.Kind)+" "+def.FullName)
+	}
+	want := []string{
+		"extension foo.v1.Foo.label",
+		"extension foo.v1.file_opt",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
+
+func TestOptionReferences(t *testing.T) {
+	proto, err := protoparser.Parse(strings.NewReader(optionsProto))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []string
+	for _, ref := range symbol.OptionReferences(proto) {
+		got = append(got, ref.Scope+" "+ref.Name)
+	}
+	want := []string{
+		"foo.v1 file_opt",
+		"foo.v1.Foo .foo.v1.message_opt",
+		"foo.v1.Foo Foo.label",
+		"foo.v1.Foo ext.tag",
+		"foo.v1.Foo oneof_opt",
+		"foo.v1.Foo a",
+		"foo.v1.Foo b.c",
+		"foo.v1.Kind enum_opt",
+		"foo.v1.Kind value_opt",
+		"foo.v1.FooService service_opt",
+		"foo.v1.FooService google.api.http",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, but want %v", got, want)
+	}
+}
+
+func TestTable_Resolve(t *testing.T) {
+	table := make(symbol.Table)
+	table.Add([]symbol.Definition{
+		{FullName: "foo.v1.Foo", Kind: symbol.KindMessage},
+		{FullName: "foo.v1.Foo.Kind", Kind: symbol.KindEnum},
+		{FullName: "foo.Kind", Kind: symbol.KindEnum},
+		{FullName: "bar.v1.Bar", Kind: symbol.KindMessage},
+		{FullName: "Top", Kind: symbol.KindMessage},
+	})
+
+	tests := []struct {
+		name         string
+		scope        string
+		typeName     string
+		wantFullName string
+		wantOK       bool
+	}{
+		{
+			name:         "nested type from the message",
+			scope:        "foo.v1.Foo",
+			typeName:     "Kind",
+			wantFullName: "foo.v1.Foo.Kind",
+			wantOK:       true,
+		},
+		{
+			name:         "type in the parent package",
+			scope:        "foo.v1",
+			typeName:     "Kind",
+			wantFullName: "foo.Kind",
+			wantOK:       true,
+		},
+		{
+			name:         "partially qualified name",
+			scope:        "foo.v1",
+			typeName:     "Foo.Kind",
+			wantFullName: "foo.v1.Foo.Kind",
+			wantOK:       true,
+		},
+		{
+			name:         "type in another package",
+			scope:        "foo.v1.Foo",
+			typeName:     "bar.v1.Bar",
+			wantFullName: "bar.v1.Bar",
+			wantOK:       true,
+		},
+		{
+			name:         "fully-qualified name",
+			scope:        "foo.v1.Foo",
+			typeName:     ".foo.Kind",
+			wantFullName: "foo.Kind",
+			wantOK:       true,
+		},
+		{
+			name:         "type without a package",
+			scope:        "foo.v1",
+			typeName:     "Top",
+			wantFullName: "Top",
+			wantOK:       true,
+		},
+		{
+			name:     "fully-qualified name not found",
+			scope:    "foo.v1",
+			typeName: ".Kind",
+		},
+		{
+			name:     "undefined type",
+			scope:    "foo.v1",
+			typeName: "Baz",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := table.Resolve(test.scope, test.typeName)
+			if ok != test.wantOK {
+				t.Fatalf("got ok %v, but want %v", ok, test.wantOK)
+			}
+			if got.FullName != test.wantFullName {
+				t.Errorf("got %s, but want %s", got.FullName, test.wantFullName)
+			}
+		})
+	}
+}
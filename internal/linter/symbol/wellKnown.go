@@ -0,0 +1,119 @@
+package symbol
+
+// wellKnownFiles are the files which protoc bundles, so that they can be imported without being found under
+// any import path. They only declare the types, which is enough to resolve the references to them.
+var wellKnownFiles = map[string]string{
+	"google/protobuf/any.proto": `syntax = "proto3";
+package google.protobuf;
+message Any {}`,
+	"google/protobuf/api.proto": `syntax = "proto3";
+package google.protobuf;
+import public "google/protobuf/source_context.proto";
+import public "google/protobuf/type.proto";
+message Api {}
+message Method {}
+message Mixin {}`,
+	"google/protobuf/duration.proto": `syntax = "proto3";
+package google.protobuf;
+message Duration {}`,
+	"google/protobuf/empty.proto": `syntax = "proto3";
+package google.protobuf;
+message Empty {}`,
+	"google/protobuf/field_mask.proto": `syntax = "proto3";
+package google.protobuf;
+message FieldMask {}`,
+	"google/protobuf/source_context.proto": `syntax = "proto3";
+package google.protobuf;
+message SourceContext {}`,
+	"google/protobuf/struct.proto": `syntax = "proto3";
+package google.protobuf;
+message Struct {}
+message Value {}
+enum NullValue { NULL_VALUE = 0; }
+message ListValue {}`,
+	"google/protobuf/timestamp.proto": `syntax = "proto3";
+package google.protobuf;
+message Timestamp {}`,
+	"google/protobuf/type.proto": `syntax = "proto3";
+package google.protobuf;
+message Type {}
+message Field {
+  enum Kind { TYPE_UNKNOWN = 0; }
+  enum Cardinality { CARDINALITY_UNKNOWN = 0; }
+}
+message Enum {}
+message EnumValue {}
+message Option {}
+enum Syntax { SYNTAX_PROTO2 = 0; }`,
+	"google/protobuf/wrappers.proto": `syntax = "proto3";
+package google.protobuf;
+message DoubleValue {}
+message FloatValue {}
+message Int64Value {}
+message UInt64Value {}
+message Int32Value {}
+message UInt32Value {}
+message BoolValue {}
+message StringValue {}
+message BytesValue {}`,
+	"google/protobuf/descriptor.proto": `syntax = "proto2";
+package google.protobuf;
+message FileDescriptorSet {}
+message FileDescriptorProto {}
+message DescriptorProto {
+  message ExtensionRange {}
+  message ReservedRange {}
+}
+message ExtensionRangeOptions {
+  message Declaration {}
+  enum VerificationState { DECLARATION = 0; }
+}
+message FieldDescriptorProto {
+  enum Type { TYPE_DOUBLE = 1; }
+  enum Label { LABEL_OPTIONAL = 1; }
+}
+message OneofDescriptorProto {}
+message EnumDescriptorProto {
+  message EnumReservedRange {}
+}
+message EnumValueDescriptorProto {}
+message ServiceDescriptorProto {}
+message MethodDescriptorProto {}
+message FileOptions {
+  enum OptimizeMode { SPEED = 1; }
+}
+message MessageOptions {}
+message FieldOptions {
+  enum CType { STRING = 0; }
+  enum JSType { JS_NORMAL = 0; }
+  enum OptionRetention { RETENTION_UNKNOWN = 0; }
+  enum OptionTargetType { TARGET_TYPE_UNKNOWN = 0; }
+  message EditionDefault {}
+  message FeatureSupport {}
+}
+message OneofOptions {}
+message EnumOptions {}
+message EnumValueOptions {}
+message ServiceOptions {}
+message MethodOptions {
+  enum IdempotencyLevel { IDEMPOTENCY_UNKNOWN = 0; }
+}
+message UninterpretedOption {
+  message NamePart {}
+}
+message FeatureSet {}
+message FeatureSetDefaults {}
+message SourceCodeInfo {
+  message Location {}
+}
+message GeneratedCodeInfo {
+  message Annotation {}
+}
+enum Edition { EDITION_UNKNOWN = 0; }`,
+}
+
+// IsWellKnown reports whether the import path is one of the files which protoc bundles.
+func IsWellKnown(importPath string) bool {
+	_, ok := wellKnownFiles[importPath]
+	return ok
+}
@@ -0,0 +1,88 @@
+package osutil
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// atomicFileMode is the permission of the file newly created by AtomicFile.
+const atomicFileMode = 0644
+
+// AtomicFile writes to a temporary file next to the target and renames it to the target on Commit,
+// so that the target never has partially written or stale content.
+//
+// If the target exists and isn't a regular file, like a symlink, a named pipe or /dev/stdout,
+// AtomicFile writes to the target directly instead, not to replace it with a regular file.
+type AtomicFile struct {
+	*os.File
+	target string
+	mode   os.FileMode
+	direct bool
+}
+
+// CreateAtomicFile creates an AtomicFile for the target, creating the parent directories too.
+// The target keeps its permission if it exists.
+func CreateAtomicFile(target string) (*AtomicFile, error) {
+	info, err := os.Lstat(target)
+	switch {
+	case err == nil && !info.Mode().IsRegular():
+		f, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, atomicFileMode)
+		if err != nil {
+			return nil, err
+		}
+		return &AtomicFile{
+			File:   f,
+			target: target,
+			direct: true,
+		}, nil
+	case err != nil && !os.IsNotExist(err):
+		return nil, err
+	}
+
+	mode := os.FileMode(atomicFileMode)
+	if info != nil {
+		mode = info.Mode().Perm()
+	}
+
+	dir := filepath.Dir(target)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	f, err := os.CreateTemp(dir, "."+filepath.Base(target)+".*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &AtomicFile{
+		File:   f,
+		target: target,
+		mode:   mode,
+	}, nil
+}
+
+// Commit closes the temporary file and replaces the target with it.
+func (f *AtomicFile) Commit() error {
+	err := f.Close()
+	if f.direct {
+		return err
+	}
+	if err == nil {
+		err = os.Chmod(f.Name(), f.mode)
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), f.target)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+	return err
+}
+
+// Discard closes and removes the temporary file, leaving the target as it is.
+// The target written directly is only closed.
+func (f *AtomicFile) Discard() error {
+	if f.direct {
+		return f.Close()
+	}
+	_ = f.Close()
+	return os.Remove(f.Name())
+}
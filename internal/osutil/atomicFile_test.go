@@ -0,0 +1,171 @@
+package osutil_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/osutil"
+)
+
+func TestAtomicFile(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputOld    *string
+		inputMode   os.FileMode
+		inputCommit bool
+		wantContent *string
+		wantMode    os.FileMode
+	}{
+		{
+			name:        "Creates the file in the new directories",
+			inputCommit: true,
+			wantContent: stringPtr("new"),
+			wantMode:    0644,
+		},
+		{
+			name:        "Keeps the permission of the old file",
+			inputOld:    stringPtr("old content"),
+			inputMode:   0600,
+			inputCommit: true,
+			wantContent: stringPtr("new"),
+			wantMode:    0600,
+		},
+		{
+			name:        "Replaces the longer file without stale bytes",
+			inputOld:    stringPtr("old content"),
+			inputCommit: true,
+			wantContent: stringPtr("new"),
+		},
+		{
+			name:        "Keeps the old file when discarded",
+			inputOld:    stringPtr("old content"),
+			wantContent: stringPtr("old content"),
+		},
+		{
+			name: "Creates no file when discarded",
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "reports", "nested")
+			target := filepath.Join(dir, "out.txt")
+			if test.inputOld != nil {
+				if err := os.MkdirAll(dir, 0755); err != nil {
+					t.Errorf("got err %v", err)
+					return
+				}
+				mode := test.inputMode
+				if mode == 0 {
+					mode = 0644
+				}
+				if err := os.WriteFile(target, []byte(*test.inputOld), mode); err != nil {
+					t.Errorf("got err %v", err)
+					return
+				}
+				if err := os.Chmod(target, mode); err != nil {
+					t.Errorf("got err %v", err)
+					return
+				}
+			}
+
+			f, err := osutil.CreateAtomicFile(target)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			if _, err := f.WriteString("new"); err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			if test.inputCommit {
+				err = f.Commit()
+			} else {
+				err = f.Discard()
+			}
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+
+			got, err := os.ReadFile(target)
+			switch {
+			case test.wantContent == nil && !os.IsNotExist(err):
+				t.Errorf("got err %v, but want no file", err)
+			case test.wantContent != nil && err != nil:
+				t.Errorf("got err %v", err)
+			case test.wantContent != nil && string(got) != *test.wantContent:
+				t.Errorf("got %q, but want %q", got, *test.wantContent)
+			}
+			if test.wantMode != 0 {
+				info, err := os.Stat(target)
+				if err != nil {
+					t.Errorf("got err %v", err)
+				} else if info.Mode().Perm() != test.wantMode {
+					t.Errorf("got mode %v, but want %v", info.Mode().Perm(), test.wantMode)
+				}
+			}
+
+			entries, err := os.ReadDir(dir)
+			if err != nil {
+				t.Errorf("got err %v", err)
+				return
+			}
+			for _, e := range entries {
+				if e.Name() != "out.txt" {
+					t.Errorf("got the leftover file %s", e.Name())
+				}
+			}
+		})
+	}
+}
+
+func TestAtomicFile_Symlink(t *testing.T) {
+	dir := t.TempDir()
+	real := filepath.Join(dir, "real.txt")
+	if err := os.WriteFile(real, []byte("old content"), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	link := filepath.Join(dir, "link.txt")
+	if err := os.Symlink(real, link); err != nil {
+		t.Skipf("symlink is not supported: %v", err)
+	}
+
+	f, err := osutil.CreateAtomicFile(link)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if _, err := f.WriteString("new"); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if err := f.Commit(); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	info, err := os.Lstat(link)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if info.Mode()&os.ModeSymlink == 0 {
+		t.Errorf("got mode %v, but want the symlink kept", info.Mode())
+	}
+	got, err := os.ReadFile(real)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if string(got) != "new" {
+		t.Errorf("got %q, but want %q", got, "new")
+	}
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -5,7 +5,8 @@ type ExitCode int
 
 // ExitCode constants.
 const (
-	ExitSuccess         ExitCode = iota
-	ExitLintFailure              // Lint errors, exclusively.
-	ExitInternalFailure          // All other errors: parsing, internal, runtime errors.
+	ExitSuccess            ExitCode = iota
+	ExitLintFailure                 // Lint errors, exclusively. Warnings and notes may also be present.
+	ExitInternalFailure             // All other errors: parsing, internal, runtime errors.
+	ExitLintWarningFailure          // Lint warnings or notes above the threshold, without any failing errors.
 )
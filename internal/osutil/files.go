@@ -0,0 +1,159 @@
+package osutil
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// Files reads and writes the files of a lint, serving the files added to it from memory instead of the disk.
+// It also keeps the files read from the disk until they are written or renamed, so that each file is read once.
+// A nil *Files reads and writes the files on the disk.
+type Files struct {
+	mu     sync.Mutex
+	memory map[string][]byte
+	read   map[string][]byte
+}
+
+// NewFiles creates a new Files with no files in memory.
+func NewFiles() *Files {
+	return &Files{
+		memory: make(map[string][]byte),
+		read:   make(map[string][]byte),
+	}
+}
+
+// Add serves the content as the file at path. The file on the disk at the same path, if any, is never touched.
+func (f *Files) Add(
+	path string,
+	content []byte,
+) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.memory[filepath.Clean(path)] = append([]byte(nil), content...)
+}
+
+// Paths returns the paths of the files in memory, which change when the files are renamed.
+func (f *Files) Paths() []string {
+	if f == nil {
+		return nil
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var paths []string
+	for path := range f.memory {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// inMemory returns a copy of the content of the file in memory.
+func (f *Files) inMemory(name string) ([]byte, bool) {
+	if f == nil {
+		return nil, false
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	content, ok := f.memory[filepath.Clean(name)]
+	return append([]byte(nil), content...), ok
+}
+
+// keepRead keeps the content of the file on the disk. A nil content forgets the file.
+func (f *Files) keepRead(name string, content []byte) {
+	if f == nil {
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if content == nil {
+		delete(f.read, filepath.Clean(name))
+		return
+	}
+	f.read[filepath.Clean(name)] = append([]byte(nil), content...)
+}
+
+// ReadFile reads the file.
+func (f *Files) ReadFile(name string) ([]byte, error) {
+	if content, ok := f.inMemory(name); ok {
+		return content, nil
+	}
+	if f != nil {
+		f.mu.Lock()
+		content, ok := f.read[filepath.Clean(name)]
+		f.mu.Unlock()
+		if ok {
+			return append([]byte(nil), content...), nil
+		}
+	}
+	content, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	f.keepRead(name, content)
+	return content, nil
+}
+
+// Open opens the file for reading.
+func (f *Files) Open(name string) (io.ReadCloser, error) {
+	if content, ok := f.inMemory(name); ok {
+		return io.NopCloser(bytes.NewReader(content)), nil
+	}
+	return os.Open(name)
+}
+
+// Exists reports whether the file exists. It returns true unless the file surely doesn't exist.
+func (f *Files) Exists(name string) bool {
+	if _, ok := f.inMemory(name); ok {
+		return true
+	}
+	_, err := os.Stat(name)
+	return !os.IsNotExist(err)
+}
+
+// Rename renames the file.
+func (f *Files) Rename(oldpath, newpath string) error {
+	if f != nil {
+		f.mu.Lock()
+		content, ok := f.memory[filepath.Clean(oldpath)]
+		if ok {
+			delete(f.memory, filepath.Clean(oldpath))
+			f.memory[filepath.Clean(newpath)] = content
+		}
+		f.mu.Unlock()
+		if ok {
+			return nil
+		}
+	}
+	f.keepRead(oldpath, nil)
+	f.keepRead(newpath, nil)
+	return os.Rename(oldpath, newpath)
+}
+
+// WriteExistingFile writes the byte array to the existing file.
+func (f *Files) WriteExistingFile(
+	name string,
+	data []byte,
+) error {
+	if f != nil {
+		f.mu.Lock()
+		_, ok := f.memory[filepath.Clean(name)]
+		if ok {
+			f.memory[filepath.Clean(name)] = append([]byte(nil), data...)
+		}
+		f.mu.Unlock()
+		if ok {
+			return nil
+		}
+	}
+	f.keepRead(name, nil)
+	if err := WriteExistingFile(name, data); err != nil {
+		return err
+	}
+	f.keepRead(name, data)
+	return nil
+}
@@ -0,0 +1,143 @@
+package osutil_test
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/osutil"
+)
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "Foo.proto")
+	renamed := filepath.Join(dir, "foo.proto")
+
+	files := osutil.NewFiles()
+	files.Add(path, []byte("old"))
+
+	got, err := files.ReadFile(path)
+	if err != nil || string(got) != "old" {
+		t.Errorf("got %q and err %v, but want old", got, err)
+	}
+	if !files.Exists(path) {
+		t.Errorf("got no file at %s", path)
+	}
+
+	if err := files.WriteExistingFile(path, []byte("new")); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if err := files.Rename(path, renamed); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	if files.Exists(path) {
+		t.Errorf("got the file at %s after the rename", path)
+	}
+
+	reader, err := files.Open(renamed)
+	if err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	got, err = io.ReadAll(reader)
+	if err != nil || string(got) != "new" {
+		t.Errorf("got %q and err %v, but want new", got, err)
+	}
+	if paths := files.Paths(); !reflect.DeepEqual(paths, []string{renamed}) {
+		t.Errorf("got %v, but want %v", paths, []string{renamed})
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil || len(entries) != 0 {
+		t.Errorf("got %v and err %v, but want no files on the disk", entries, err)
+	}
+}
+
+func TestFiles_separated(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "foo.proto")
+	if err := os.WriteFile(path, []byte("disk"), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	files := osutil.NewFiles()
+	files.Add(path, []byte("memory"))
+	if err := files.WriteExistingFile(path, []byte("fixed")); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	for _, test := range []struct {
+		name  string
+		files *osutil.Files
+		want  string
+	}{
+		{
+			name:  "the files added in memory",
+			files: files,
+			want:  "fixed",
+		},
+		{
+			name:  "other files",
+			files: osutil.NewFiles(),
+			want:  "disk",
+		},
+		{
+			name: "nil reads the disk",
+			want: "disk",
+		},
+	} {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.files.ReadFile(path)
+			if err != nil || string(got) != test.want {
+				t.Errorf("got %q and err %v, but want %q", got, err, test.want)
+			}
+		})
+	}
+}
+
+func TestFiles_readOnce(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "Foo.proto")
+	renamed := filepath.Join(dir, "foo.proto")
+	if err := os.WriteFile(path, []byte("disk"), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+
+	files := osutil.NewFiles()
+	read := func(name string, want string) {
+		t.Helper()
+		got, err := files.ReadFile(name)
+		if err != nil || string(got) != want {
+			t.Errorf("got %q and err %v, but want %q", got, err, want)
+		}
+	}
+
+	read(path, "disk")
+	if err := os.WriteFile(path, []byte("changed"), 0644); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	read(path, "disk")
+
+	if err := files.WriteExistingFile(path, []byte("fixed")); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	read(path, "fixed")
+
+	if err := files.Rename(path, renamed); err != nil {
+		t.Errorf("got err %v", err)
+		return
+	}
+	read(renamed, "fixed")
+	if _, err := files.ReadFile(path); err == nil {
+		t.Errorf("got no err after the rename")
+	}
+}
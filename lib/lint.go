@@ -10,6 +10,8 @@ import (
 var (
 	// ErrLintFailure error is returned when there is a linting error
 	ErrLintFailure = libinternal.ErrLintFailure
+	// ErrLintWarningFailure error is returned when there are only linting warnings above the threshold.
+	ErrLintWarningFailure = libinternal.ErrLintWarningFailure
 	// ErrInternalFailure error is returned when there is a parsing, internal, or runtime error.
 	ErrInternalFailure = libinternal.ErrInternalFailure
 )
@@ -30,7 +32,8 @@ func GetLintRunner() LintRunner {
 // Lint is used to lint Protocol Buffer files with the protolint tool.
 // It takes an array of strings (args) representing command line arguments,
 // as well as two io.Writer instances (stdout and stderr) to which the output of the command should be written.
-// It returns an error in the case of a linting error (ErrLintFailure)
+// It returns an error in the case of a linting error (ErrLintFailure),
+// only linting warnings above the threshold (ErrLintWarningFailure)
 // or a parsing, internal, or runtime error (ErrInternalFailure).
 // Otherwise, it returns nil on success.
 //
@@ -2,7 +2,6 @@ package fixer
 
 import (
 	"bytes"
-	"io/ioutil"
 	"strings"
 
 	"github.com/yoheimuta/go-protoparser/v4/lexer"
@@ -40,8 +39,13 @@ type Fixing interface {
 
 // NewFixing creates a fixing, depending on fixMode.
 func NewFixing(fixMode bool, proto *parser.Proto) (Fixing, error) {
+	return NewFixingWithFiles(fixMode, proto, nil)
+}
+
+// NewFixingWithFiles creates a fixing like NewFixing, which reads and writes the proto file through files.
+func NewFixingWithFiles(fixMode bool, proto *parser.Proto, files *osutil.Files) (Fixing, error) {
 	if fixMode {
-		return NewBaseFixing(proto.Meta.Filename)
+		return NewBaseFixingWithFiles(proto.Meta.Filename, files)
 	}
 	return NopFixing{}, nil
 }
@@ -52,11 +56,17 @@ type BaseFixing struct {
 	lineEnding string
 	fileName   string
 	textEdits  []TextEdit
+	files      *osutil.Files
 }
 
 // NewBaseFixing creates a BaseFixing.
 func NewBaseFixing(protoFileName string) (*BaseFixing, error) {
-	content, err := ioutil.ReadFile(protoFileName)
+	return NewBaseFixingWithFiles(protoFileName, nil)
+}
+
+// NewBaseFixingWithFiles creates a BaseFixing which reads and writes the file through files.
+func NewBaseFixingWithFiles(protoFileName string, files *osutil.Files) (*BaseFixing, error) {
+	content, err := files.ReadFile(protoFileName)
 	if err != nil {
 		return nil, err
 	}
@@ -72,6 +82,7 @@ func NewBaseFixing(protoFileName string) (*BaseFixing, error) {
 		content:    content,
 		lineEnding: lineEnding,
 		fileName:   protoFileName,
+		files:      files,
 	}, nil
 }
 
@@ -124,7 +135,7 @@ func (f *BaseFixing) Finally() error {
 		f.content = append(f.content[:t.Pos], append(t.NewText, f.content[t.End+1:]...)...)
 		diff += len(t.NewText) - (t.End - t.Pos + 1)
 	}
-	return osutil.WriteExistingFile(f.fileName, f.content)
+	return f.files.WriteExistingFile(f.fileName, f.content)
 }
 
 // Replace records a textedit to replace the old with the next later.
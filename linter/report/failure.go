@@ -11,6 +11,7 @@ import (
 // Failure represents a lint error information.
 type Failure struct {
 	pos      meta.Position
+	end      meta.Position
 	message  string
 	ruleID   string
 	severity string
@@ -32,6 +33,21 @@ func Failuref(
 	}
 }
 
+// FailureWithRangef creates a new Failure spanning from pos to end and the formatting works like fmt.Sprintf.
+// end points at the last character of the range.
+func FailureWithRangef(
+	pos meta.Position,
+	end meta.Position,
+	ruleID string,
+	severity string,
+	format string,
+	a ...interface{},
+) Failure {
+	f := Failuref(pos, ruleID, severity, format, a...)
+	f.end = end
+	return f
+}
+
 // String stringifies Failure.
 func (f Failure) String() string {
 	return fmt.Sprintf("[%s] %s", f.pos, f.message)
@@ -47,6 +63,17 @@ func (f Failure) Pos() meta.Position {
 	return f.pos
 }
 
+// End returns the position of the last character of the range.
+// It returns the zero value when the range is unknown.
+func (f Failure) End() meta.Position {
+	return f.end
+}
+
+// HasRange reports whether the end position is known.
+func (f Failure) HasRange() bool {
+	return 0 < f.end.Line
+}
+
 // RuleID returns a rule ID.
 func (f Failure) RuleID() string {
 	return f.ruleID
@@ -43,6 +43,30 @@ type HasIsOfficial interface {
 	IsOfficial() bool
 }
 
+// HasIsFixable represents a rule which can fix the failures with the -fix option.
+// This is optional for a Rule.
+type HasIsFixable interface {
+	// IsFixable decides whether or not this rule can fix the failures.
+	IsFixable() bool
+}
+
+// Documentation describes a rule beyond its purpose, for the users to understand why it exists.
+type Documentation struct {
+	// Rationale explains why the rule is worth following.
+	Rationale string
+	// Bad is an example of the proto which the rule reports.
+	Bad string
+	// Good is the example rewritten to follow the rule.
+	Good string
+}
+
+// HasDocumentation represents a rule with the documentation.
+// This is optional for a Rule.
+type HasDocumentation interface {
+	// Documentation returns the rationale and the examples of this rule.
+	Documentation() Documentation
+}
+
 // HasSeverity represents a rule with a configurable severity
 type HasSeverity interface {
 	// Severity returns the selected severity of a rule
@@ -13,13 +13,23 @@ type BaseAddVisitor struct {
 	ruleID   string
 	severity string
 	failures []report.Failure
+	// env reads the files to locate the names.
+	env Env
+	// contents caches the files read to locate the names.
+	contents map[string][]byte
 }
 
 // NewBaseAddVisitor creates a BaseAddVisitor.
 func NewBaseAddVisitor(ruleID string, severity string) *BaseAddVisitor {
+	return NewBaseAddVisitorWithEnv(ruleID, severity, Env{})
+}
+
+// NewBaseAddVisitorWithEnv creates a BaseAddVisitor which reads the files through env to locate the names.
+func NewBaseAddVisitorWithEnv(ruleID string, severity string, env Env) *BaseAddVisitor {
 	return &BaseAddVisitor{
 		ruleID:   ruleID,
 		severity: severity,
+		env:      env,
 	}
 }
 
@@ -37,6 +47,75 @@ func (v *BaseAddVisitor) AddFailuref(
 	v.failures = append(v.failures, report.Failuref(pos, v.ruleID, v.severity, format, a...))
 }
 
+// AddFailureWithRangef adds to the internal buffer the failure spanning from pos to end.
+// The formatting works like fmt.Sprintf.
+func (v *BaseAddVisitor) AddFailureWithRangef(
+	pos meta.Position,
+	end meta.Position,
+	format string,
+	a ...interface{},
+) {
+	v.failures = append(v.failures, report.FailureWithRangef(pos, end, v.ruleID, v.severity, format, a...))
+}
+
+// AddFailureAtNamef adds to the internal buffer the failure at pos, spanning up to the end of the name
+// which the element from pos to end declares. The formatting works like fmt.Sprintf.
+// It adds the failure without the range instead if the name isn't found in the file.
+func (v *BaseAddVisitor) AddFailureAtNamef(
+	pos meta.Position,
+	end meta.Position,
+	name string,
+	format string,
+	a ...interface{},
+) {
+	v.addFailureAtf(pos, end, name, true, format, a...)
+}
+
+// AddFailureAtTypef adds to the internal buffer the failure at pos, spanning up to the end of the type name
+// which the element from pos to end refers to. The formatting works like fmt.Sprintf.
+// It adds the failure without the range instead if the type name isn't found in the file.
+func (v *BaseAddVisitor) AddFailureAtTypef(
+	pos meta.Position,
+	end meta.Position,
+	typeName string,
+	format string,
+	a ...interface{},
+) {
+	v.addFailureAtf(pos, end, typeName, false, format, a...)
+}
+
+func (v *BaseAddVisitor) addFailureAtf(
+	pos meta.Position,
+	end meta.Position,
+	name string,
+	declared bool,
+	format string,
+	a ...interface{},
+) {
+	last, ok := nameEnd(v.content(pos.Filename), pos, end, name, declared)
+	if !ok {
+		v.AddFailuref(pos, format, a...)
+		return
+	}
+	v.AddFailureWithRangef(pos, last, format, a...)
+}
+
+// content returns the content of the file, or nil if it can't be read.
+func (v *BaseAddVisitor) content(filename string) []byte {
+	if content, ok := v.contents[filename]; ok {
+		return content
+	}
+	if v.contents == nil {
+		v.contents = make(map[string][]byte)
+	}
+	content, err := v.env.Files.ReadFile(filename)
+	if err != nil {
+		content = nil
+	}
+	v.contents[filename] = content
+	return content
+}
+
 // AddFailurefWithProtoMeta adds to the internal buffer and the formatting works like fmt.Sprintf.
 func (v *BaseAddVisitor) AddFailurefWithProtoMeta(
 	p *parser.ProtoMeta,
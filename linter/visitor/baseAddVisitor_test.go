@@ -0,0 +1,189 @@
+package visitor_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	protoparser "github.com/yoheimuta/go-protoparser/v4"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+const namesProto = `syntax = "proto3";
+package foo.bar;
+
+message Foo {
+  Foo Foo = 1;
+  map<string, Foo> foo_map = 2;
+}
+
+service FooService {
+  rpc Foo(Foo) returns (Foo) {}
+}
+`
+
+func TestBaseAddVisitor_AddFailureAtNamef(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "names.proto")
+	if err := os.WriteFile(path, []byte(namesProto), 0644); err != nil {
+		t.Fatal(err)
+	}
+	proto, err := protoparser.Parse(strings.NewReader(namesProto), protoparser.WithFilename(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkg := proto.ProtoBody[0].(*parser.Package)
+	message := proto.ProtoBody[1].(*parser.Message)
+	field := message.MessageBody[0].(*parser.Field)
+	mapField := message.MessageBody[1].(*parser.MapField)
+	rpc := proto.ProtoBody[2].(*parser.Service).ServiceBody[0].(*parser.RPC)
+
+	missing := message.Meta.Pos
+	missing.Filename = filepath.Join(filepath.Dir(path), "notfound.proto")
+
+	// position returns the position at the column of the line in namesProto.
+	position := func(line, column int) meta.Position {
+		offset := column - 1
+		for _, l := range strings.Split(namesProto, "\n")[:line-1] {
+			offset += len(l) + 1
+		}
+		return meta.Position{Filename: path, Offset: offset, Line: line, Column: column}
+	}
+
+	tests := []struct {
+		name      string
+		add       func(v *visitor.BaseAddVisitor)
+		wantPos   meta.Position
+		wantEnd   meta.Position
+		wantRange bool
+	}{
+		{
+			name: "the dotted package name",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtNamef(pkg.Meta.Pos, pkg.Meta.LastPos, pkg.Name, "message")
+			},
+			wantPos:   pkg.Meta.Pos,
+			wantEnd:   position(2, 15),
+			wantRange: true,
+		},
+		{
+			name: "the message name rather than the body",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtNamef(message.Meta.Pos, message.Meta.LastPos, message.MessageName, "message")
+			},
+			wantPos:   message.Meta.Pos,
+			wantEnd:   position(4, 11),
+			wantRange: true,
+		},
+		{
+			name: "the field name rather than the same type name",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtNamef(field.Meta.Pos, field.Meta.LastPos, field.FieldName, "message")
+			},
+			wantPos:   field.Meta.Pos,
+			wantEnd:   position(5, 9),
+			wantRange: true,
+		},
+		{
+			name: "the type of the field",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtTypef(field.Meta.Pos, field.Meta.LastPos, field.Type, "message")
+			},
+			wantPos:   field.Meta.Pos,
+			wantEnd:   position(5, 5),
+			wantRange: true,
+		},
+		{
+			name: "the map field name",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtNamef(mapField.Meta.Pos, mapField.Meta.LastPos, mapField.MapName, "message")
+			},
+			wantPos:   mapField.Meta.Pos,
+			wantEnd:   position(6, 26),
+			wantRange: true,
+		},
+		{
+			name: "the rpc name rather than the request type",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtNamef(rpc.Meta.Pos, rpc.Meta.LastPos, rpc.RPCName, "message")
+			},
+			wantPos:   rpc.Meta.Pos,
+			wantEnd:   position(10, 9),
+			wantRange: true,
+		},
+		{
+			name: "the response type of the rpc",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtTypef(rpc.RPCResponse.Meta.Pos, rpc.RPCResponse.Meta.LastPos, rpc.RPCResponse.MessageType, "message")
+			},
+			wantPos:   rpc.RPCResponse.Meta.Pos,
+			wantEnd:   position(10, 27),
+			wantRange: true,
+		},
+		{
+			name: "the position of the element for the name not found",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtNamef(message.Meta.Pos, message.Meta.LastPos, "Bar", "message")
+			},
+			wantPos: message.Meta.Pos,
+		},
+		{
+			name: "the position of the element for the file not found",
+			add: func(v *visitor.BaseAddVisitor) {
+				v.AddFailureAtNamef(missing, message.Meta.LastPos, message.MessageName, "message")
+			},
+			wantPos: missing,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+		t.Run(test.name, func(t *testing.T) {
+			v := visitor.NewBaseAddVisitor("RULE", "error")
+			test.add(v)
+
+			failures := v.Failures()
+			if len(failures) != 1 {
+				t.Fatalf("got %v, but want one failure", failures)
+			}
+			got := failures[0]
+			if got.HasRange() != test.wantRange {
+				t.Errorf("got range %v, but want %v", got.HasRange(), test.wantRange)
+			}
+			if got.Pos() != test.wantPos {
+				t.Errorf("got %v, but want %v", got.Pos(), test.wantPos)
+			}
+			if test.wantRange && got.End() != test.wantEnd {
+				t.Errorf("got %v, but want %v", got.End(), test.wantEnd)
+			}
+		})
+	}
+}
+
+func TestBaseAddVisitor_AddFailureAtNamef_withEnv(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "names.proto")
+	proto, err := protoparser.Parse(strings.NewReader(namesProto), protoparser.WithFilename(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := proto.ProtoBody[1].(*parser.Message)
+
+	files := osutil.NewFiles()
+	files.Add(path, []byte(namesProto))
+	v := visitor.NewBaseAddVisitorWithEnv("RULE", "error", visitor.Env{Files: files})
+	v.AddFailureAtNamef(message.Meta.Pos, message.Meta.LastPos, message.MessageName, "message")
+
+	failures := v.Failures()
+	if len(failures) != 1 {
+		t.Fatalf("got %v, but want one failure", failures)
+	}
+	want := meta.Position{Filename: path, Offset: message.Meta.Pos.Offset + 10, Line: 4, Column: 11}
+	if got := failures[0]; got.Pos() != message.Meta.Pos || got.End() != want {
+		t.Errorf("got %v to %v, but want %v to %v", got.Pos(), got.End(), message.Meta.Pos, want)
+	}
+}
@@ -2,6 +2,7 @@ package visitor
 
 import (
 	"github.com/yoheimuta/go-protoparser/v4/parser"
+
 	"github.com/maramkhaledn/protolint/linter/fixer"
 )
 
@@ -20,14 +21,46 @@ func NewBaseFixableVisitor(
 	proto *parser.Proto,
 	severity string,
 ) (*BaseFixableVisitor, error) {
-	f, err := fixer.NewFixing(fixMode, proto)
+	return NewBaseFixableVisitorWithEnv(ruleID, fixMode, Env{}, proto, severity)
+}
+
+// NewBaseFixableVisitorWithEnv creates a BaseFixableVisitor which fixes the proto file through env.
+// If env has a FixRecorder, the fixer records the fixes to it instead of fixing the file.
+func NewBaseFixableVisitorWithEnv(
+	ruleID string,
+	fixMode bool,
+	env Env,
+	proto *parser.Proto,
+	severity string,
+) (*BaseFixableVisitor, error) {
+	base := NewBaseAddVisitorWithEnv(ruleID, severity, env)
+
+	var f fixer.Fixing
+	var err error
+	if recorder := env.FixRecorder; recorder != nil {
+		f, err = newRecordingFixing(proto, base, recorder)
+	} else {
+		f, err = fixer.NewFixingWithFiles(fixMode, proto, env.Files)
+	}
 	if err != nil {
 		return nil, err
 	}
+
+	finallyFn := f.Finally
+	if fixing, ok := f.(*fixer.BaseFixing); ok && env.FixCounter != nil {
+		before := append([]byte(nil), fixing.Content()...)
+		finallyFn = func() error {
+			if err := fixing.Finally(); err != nil {
+				return err
+			}
+			env.FixCounter.Add(before, fixing.Content())
+			return nil
+		}
+	}
 	return &BaseFixableVisitor{
-		BaseAddVisitor: NewBaseAddVisitor(ruleID, severity),
+		BaseAddVisitor: base,
 		Fixer:          f,
-		finallyFn:      f.Finally,
+		finallyFn:      finallyFn,
 	}, nil
 }
 
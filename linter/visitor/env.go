@@ -0,0 +1,18 @@
+package visitor
+
+import (
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+)
+
+// Env is what the visitors of a lint run read and fix the files through.
+// The zero value reads and writes the files on the disk.
+type Env struct {
+	// Files serves the files to the visitors. Nil means the disk.
+	Files *osutil.Files
+	// FixRecorder records the fixes which the fixable rules would make with -fix, without fixing the files.
+	// Nil makes the fixes depending on the fix mode.
+	FixRecorder *internalreport.FixRecorder
+	// FixCounter counts the replacements which the fixers apply to the files. Nil counts nothing.
+	FixCounter *internalreport.FixCounter
+}
@@ -0,0 +1,92 @@
+package visitor
+
+import (
+	"bytes"
+	"unicode/utf8"
+
+	"github.com/yoheimuta/go-protoparser/v4/lexer"
+	"github.com/yoheimuta/go-protoparser/v4/lexer/scanner"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+)
+
+type token struct {
+	token scanner.Token
+	pos   meta.Position
+}
+
+// nameEnd returns the position of the last character of the name, which may be dotted,
+// written in the content between pos and end.
+// If declared is true, only the name followed by "=", "{", "(" or ";" matches,
+// so that the declared name is told from the type of a field like "Foo Foo = 1;".
+// Otherwise, the first occurrence of the name matches.
+func nameEnd(
+	content []byte,
+	pos meta.Position,
+	end meta.Position,
+	name string,
+	declared bool,
+) (meta.Position, bool) {
+	if len(name) == 0 || pos.Offset < 0 || end.Offset < pos.Offset || len(content) <= end.Offset {
+		return meta.Position{}, false
+	}
+
+	src := content[pos.Offset : end.Offset+1]
+	lex := lexer.NewLexer(bytes.NewReader(src))
+	lex.Error = func(*lexer.Lexer, error) {}
+	var tokens []token
+	for lex.Next(); !lex.IsEOF() && lex.LatestErr() == nil; lex.Next() {
+		tokens = append(tokens, token{token: lex.Token, pos: lex.Pos.Position})
+	}
+
+	for i, t := range tokens {
+		if t.token != scanner.TIDENT && t.token != scanner.TDOT {
+			continue
+		}
+		rest := src[t.pos.Offset:]
+		if !bytes.HasPrefix(rest, []byte(name)) || (len(name) < len(rest) && isNameByte(rest[len(name)])) {
+			continue
+		}
+		if declared && !followedByDeclaration(tokens[i+1:], t.pos.Offset+len(name)) {
+			continue
+		}
+
+		last := absolutePosition(pos, t.pos)
+		last.Offset += len(name) - 1
+		last.Column += utf8.RuneCountInString(name) - 1
+		return last, true
+	}
+	return meta.Position{}, false
+}
+
+// followedByDeclaration reports whether the first token from the offset ends the declared name.
+func followedByDeclaration(tokens []token, offset int) bool {
+	for _, t := range tokens {
+		if t.pos.Offset < offset {
+			continue
+		}
+		switch t.token {
+		case scanner.TEQUALS, scanner.TLEFTCURLY, scanner.TLEFTPAREN, scanner.TSEMICOLON:
+			return true
+		}
+		return false
+	}
+	return false
+}
+
+func isNameByte(b byte) bool {
+	return b == '_' || b == '.' || '0' <= b && b <= '9' || 'a' <= b && b <= 'z' || 'A' <= b && b <= 'Z'
+}
+
+// absolutePosition converts the position relative to base into the one in the file.
+func absolutePosition(base meta.Position, rel meta.Position) meta.Position {
+	abs := meta.Position{
+		Filename: base.Filename,
+		Offset:   base.Offset + rel.Offset,
+		Line:     base.Line + rel.Line - 1,
+		Column:   rel.Column,
+	}
+	if rel.Line == 1 {
+		abs.Column = base.Column + rel.Column - 1
+	}
+	return abs
+}
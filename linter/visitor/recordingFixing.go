@@ -0,0 +1,152 @@
+package visitor
+
+import (
+	"github.com/yoheimuta/go-protoparser/v4/lexer"
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+	"github.com/yoheimuta/go-protoparser/v4/parser/meta"
+
+	internalreport "github.com/maramkhaledn/protolint/internal/linter/report"
+	"github.com/maramkhaledn/protolint/linter/fixer"
+	"github.com/maramkhaledn/protolint/linter/report"
+)
+
+// recordingFixing fixes the content in memory and records the fix of each failure instead of writing the file.
+//
+// A fix made right after adding failures, like SearchAndReplace, belongs to them.
+// A fix of the whole content belongs to the failures added while it's made, or otherwise
+// each replacement belongs to the failures on its lines, like the fixes made in Finally.
+type recordingFixing struct {
+	*fixer.BaseFixing
+	visitor  *BaseAddVisitor
+	recorder *internalreport.FixRecorder
+	// located is the number of the failures added before the last fix.
+	located int
+}
+
+func newRecordingFixing(
+	proto *parser.Proto,
+	visitor *BaseAddVisitor,
+	recorder *internalreport.FixRecorder,
+) (*recordingFixing, error) {
+	base, err := fixer.NewBaseFixingWithFiles(proto.Meta.Filename, visitor.env.Files)
+	if err != nil {
+		return nil, err
+	}
+	return &recordingFixing{
+		BaseFixing: base,
+		visitor:    visitor,
+		recorder:   recorder,
+	}, nil
+}
+
+// ReplaceText replaces the text at the line and records it for the last failures.
+func (f *recordingFixing) ReplaceText(line int, old, new string) {
+	before := f.Content()
+	f.BaseFixing.ReplaceText(line, old, new)
+	f.record(before, f.Content(), f.located)
+}
+
+// ReplaceAll replaces the lines and records it.
+func (f *recordingFixing) ReplaceAll(proc func(lines []string) []string) {
+	before := f.Content()
+	added := len(f.visitor.Failures())
+	f.BaseFixing.ReplaceAll(proc)
+	f.record(before, f.Content(), added)
+}
+
+// ReplaceContent replaces entire content and records it.
+func (f *recordingFixing) ReplaceContent(proc func(content []byte) []byte) {
+	before := f.Content()
+	added := len(f.visitor.Failures())
+	f.BaseFixing.ReplaceContent(proc)
+	f.record(before, f.Content(), added)
+}
+
+// SearchAndReplace locates the text edit and records it for the last failures.
+// The content is kept as it is, the same as BaseFixing until Finally.
+func (f *recordingFixing) SearchAndReplace(startPos meta.Position, lex func(lex *lexer.Lexer) fixer.TextEdit) error {
+	var edit fixer.TextEdit
+	err := f.BaseFixing.SearchAndReplace(startPos, func(l *lexer.Lexer) fixer.TextEdit {
+		edit = lex(l)
+		return edit
+	})
+	if err != nil {
+		return err
+	}
+
+	before := f.Content()
+	start := startPos.Offset + edit.Pos
+	end := startPos.Offset + edit.End + 1
+	if start < 0 || end < start || len(before) < end {
+		return nil
+	}
+	after := append(append(append([]byte{}, before[:start]...), edit.NewText...), before[end:]...)
+	f.record(before, after, f.located)
+	return nil
+}
+
+// Finally does nothing, not to write the file.
+func (f *recordingFixing) Finally() error {
+	return nil
+}
+
+// record records the replacements from before to after for the failures from the index from.
+// If there are no such failures, each replacement is recorded for the failures on its lines.
+func (f *recordingFixing) record(
+	before []byte,
+	after []byte,
+	from int,
+) {
+	failures := f.visitor.Failures()
+	defer func() {
+		f.located = len(failures)
+	}()
+
+	replacements := internalreport.NewReplacements(string(before), string(after))
+	if len(replacements) == 0 {
+		return
+	}
+
+	if from < len(failures) {
+		for _, failure := range failures[from:] {
+			f.recorder.Record(failure, replacements)
+		}
+		return
+	}
+
+	for _, replacement := range replacements {
+		var owners []report.Failure
+		for _, failure := range failures {
+			if overlaps(failure, replacement) {
+				owners = append(owners, failure)
+			}
+		}
+		if len(owners) == 0 {
+			owners = failures
+		}
+		for _, owner := range owners {
+			f.recorder.Record(owner, []internalreport.Replacement{replacement})
+		}
+	}
+}
+
+// overlaps reports whether the replacement touches the lines of the failure.
+// An insertion before a line touches the previous line too, like adding a newline after it.
+func overlaps(
+	failure report.Failure,
+	replacement internalreport.Replacement,
+) bool {
+	first, last := failure.Pos().Line, failure.Pos().Line
+	if failure.HasRange() {
+		last = failure.End().Line
+	}
+	start, end := replacement.StartLine, replacement.EndLine
+	if start == end && replacement.StartColumn == replacement.EndColumn {
+		start--
+	}
+	if start < end && replacement.EndColumn == 1 {
+		// The replacement ends before the line.
+		end--
+	}
+	return start <= last && first <= end
+}
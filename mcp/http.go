@@ -0,0 +1,300 @@
+package mcp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/maramkhaledn/protolint/internal/osutil"
+)
+
+const (
+	// httpEndpoint is the path of the MCP endpoint served over HTTP
+	httpEndpoint = "/mcp"
+
+	sessionIDHeader       = "Mcp-Session-Id"
+	protocolVersionHeader = "MCP-Protocol-Version"
+
+	// maxRequestBodySize limits the size of a message posted to the endpoint
+	maxRequestBodySize = 16 << 20
+
+	// sessionIdleTimeout is the time after which a session unused expires
+	sessionIdleTimeout = 30 * time.Minute
+)
+
+// httpTransport serves the MCP endpoint with the streamable HTTP transport.
+// Each client gets its own session from the initialize request.
+// The server always responds with a single JSON object and doesn't open SSE streams.
+type httpTransport struct {
+	server      *Server
+	idleTimeout time.Duration
+
+	mu       sync.Mutex
+	sessions map[string]*httpSession
+}
+
+// httpSession holds the state of a client negotiated on initialization
+type httpSession struct {
+	protocolVersion string
+	inflight        *inflightRequests
+	lastUsed        time.Time
+}
+
+func newHTTPTransport(s *Server) *httpTransport {
+	return &httpTransport{
+		server:      s,
+		idleTimeout: sessionIdleTimeout,
+		sessions:    make(map[string]*httpSession),
+	}
+}
+
+// HTTPHandler returns the handler serving the MCP endpoint at /mcp with the streamable HTTP transport
+func (s *Server) HTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(httpEndpoint, newHTTPTransport(s))
+	return mux
+}
+
+// RunHTTP starts the MCP server listening on addr until it receives an interrupt.
+// The host of addr must be a loopback address because the server can read and fix any file the user can.
+func (s *Server) RunHTTP(addr string) osutil.ExitCode {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		_, _ = fmt.Fprintf(s.stderr, "Error listening on %s: %v\n", addr, err)
+		return osutil.ExitInternalFailure
+	}
+	if !isLoopbackHost(host) {
+		_, _ = fmt.Fprintf(s.stderr, "Error listening on %s: the host must be a loopback address, like 127.0.0.1 or localhost\n", addr)
+		return osutil.ExitInternalFailure
+	}
+
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		_, _ = fmt.Fprintf(s.stderr, "Error listening on %s: %v\n", addr, err)
+		return osutil.ExitInternalFailure
+	}
+	_, _ = fmt.Fprintf(s.stderr, "protolint MCP server is listening on http://%s%s. cwd: %s\n",
+		listener.Addr(), httpEndpoint, getCurrentDir())
+
+	httpServer := &http.Server{
+		Handler:           s.HTTPHandler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = httpServer.Shutdown(shutdownCtx)
+	}()
+
+	if err := httpServer.Serve(listener); !errors.Is(err, http.ErrServerClosed) {
+		_, _ = fmt.Fprintf(s.stderr, "Error serving HTTP: %v\n", err)
+		return osutil.ExitInternalFailure
+	}
+	return osutil.ExitSuccess
+}
+
+// ServeHTTP handles a request to the MCP endpoint
+func (t *httpTransport) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// Reject the requests from web pages and to other hosts to protect the local server against DNS rebinding
+	if !isAllowedHost(r.Host) {
+		http.Error(w, "Forbidden host", http.StatusForbidden)
+		return
+	}
+	if !isAllowedOrigin(r.Header.Get("Origin")) {
+		http.Error(w, "Forbidden origin", http.StatusForbidden)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPost:
+		t.handlePost(w, r)
+	case http.MethodDelete:
+		t.handleDelete(w, r)
+	default:
+		// We don't push server-initiated messages, so there is no stream for GET
+		w.Header().Set("Allow", "POST, DELETE")
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (t *httpTransport) handlePost(w http.ResponseWriter, r *http.Request) {
+	if accept := r.Header.Get("Accept"); accept != "" &&
+		!strings.Contains(accept, "application/json") && !strings.Contains(accept, "*/*") {
+		http.Error(w, "Not acceptable: the response is application/json", http.StatusNotAcceptable)
+		return
+	}
+
+	var request Request
+	if err := json.NewDecoder(io.LimitReader(r.Body, maxRequestBodySize)).Decode(&request); err != nil {
+		writeJSON(w, http.StatusBadRequest, &Response{
+			JSONRPC: "2.0",
+			Error: &Error{
+				Code:    -32700, // Parse error
+				Message: fmt.Sprintf("Error decoding request: %v", err),
+			},
+		})
+		return
+	}
+	if request.JSONRPC == "" {
+		request.JSONRPC = "2.0"
+	}
+
+	// The initialize request has no session yet, nor can it be cancelled
+	inflight := newInflightRequests()
+	if request.Method != "initialize" {
+		session, status, message := t.lookupSession(r)
+		if session == nil {
+			http.Error(w, message, status)
+			return
+		}
+		if version := r.Header.Get(protocolVersionHeader); version != "" && version != session.protocolVersion {
+			http.Error(w, fmt.Sprintf("Unsupported protocol version: %s", version), http.StatusBadRequest)
+			return
+		}
+		inflight = session.inflight
+	}
+
+	// The messages without a method are the responses from the client, which we never ask for
+	if request.Method == "" {
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	// The request is cancelled when the client disconnects too.
+	// We can't send notifications without a stream, so the progress isn't reported.
+	response := t.server.handleMessage(r.Context(), inflight, &request, nil)
+	if request.ID == nil || response == nil {
+		// Notifications don't require a response
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	if result, ok := response.Result.(InitializeResult); ok {
+		sessionID, err := t.newSession(result.ProtocolVersion)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Failed to create a session: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(sessionIDHeader, sessionID)
+	}
+	writeJSON(w, http.StatusOK, response)
+}
+
+func (t *httpTransport) handleDelete(w http.ResponseWriter, r *http.Request) {
+	session, status, message := t.lookupSession(r)
+	if session == nil {
+		http.Error(w, message, status)
+		return
+	}
+
+	t.mu.Lock()
+	delete(t.sessions, r.Header.Get(sessionIDHeader))
+	t.mu.Unlock()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// lookupSession returns the session of the request, or the HTTP status and the message to reject the request with
+func (t *httpTransport) lookupSession(r *http.Request) (*httpSession, int, string) {
+	sessionID := r.Header.Get(sessionIDHeader)
+	if sessionID == "" {
+		return nil, http.StatusBadRequest, "Missing " + sessionIDHeader + " header"
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	session, ok := t.sessions[sessionID]
+	if ok && t.expired(session, now) {
+		delete(t.sessions, sessionID)
+		ok = false
+	}
+	if !ok {
+		// The client starts a new session on 404
+		return nil, http.StatusNotFound, "Session not found"
+	}
+	session.lastUsed = now
+	return session, 0, ""
+}
+
+// expired reports whether the session has been unused for the idle timeout. Zero means no timeout.
+func (t *httpTransport) expired(session *httpSession, now time.Time) bool {
+	return 0 < t.idleTimeout && t.idleTimeout <= now.Sub(session.lastUsed)
+}
+
+func (t *httpTransport) newSession(protocolVersion string) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	sessionID := hex.EncodeToString(b)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	now := time.Now()
+	// Drop the sessions which the clients left without deleting them
+	for id, session := range t.sessions {
+		if t.expired(session, now) {
+			delete(t.sessions, id)
+		}
+	}
+	t.sessions[sessionID] = &httpSession{
+		protocolVersion: protocolVersion,
+		inflight:        newInflightRequests(),
+		lastUsed:        now,
+	}
+	return sessionID, nil
+}
+
+// isAllowedOrigin reports whether the origin is empty, which is the case for non-browser clients, or the loopback host
+func isAllowedOrigin(origin string) bool {
+	if origin == "" {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return isLoopbackHost(u.Hostname())
+}
+
+// isAllowedHost reports whether the Host header, which may have a port, names the loopback host
+func isAllowedHost(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		// No port
+		host = strings.TrimSuffix(strings.TrimPrefix(hostport, "["), "]")
+	}
+	return isLoopbackHost(host)
+}
+
+// isLoopbackHost reports whether the host is localhost or a loopback IP address
+func isLoopbackHost(host string) bool {
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
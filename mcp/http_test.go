@@ -0,0 +1,258 @@
+package mcp
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maramkhaledn/protolint/internal/osutil"
+)
+
+const initializeRequest = `{"jsonrpc": "2.0", "method": "initialize", "id": 1, "params": {"protocolVersion": "2025-03-26", "capabilities": {}}}`
+
+func postMCP(t *testing.T, url string, body string, header map[string]string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json, text/event-stream")
+	for k, v := range header {
+		if k == "Host" {
+			// The client sends req.Host instead of the header
+			req.Host = v
+			continue
+		}
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func initializeSession(t *testing.T, url string) string {
+	t.Helper()
+	resp := postMCP(t, url, initializeRequest, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200 for initialize, got %d", resp.StatusCode)
+	}
+	sessionID := resp.Header.Get(sessionIDHeader)
+	if sessionID == "" {
+		t.Fatalf("Expected %s header for initialize", sessionIDHeader)
+	}
+	return sessionID
+}
+
+func TestHTTPHandler_Initialize(t *testing.T) {
+	ts := httptest.NewServer(NewServer("test", io.Discard, io.Discard).HTTPHandler())
+	defer ts.Close()
+
+	resp := postMCP(t, ts.URL+"/mcp", initializeRequest, nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/json" {
+		t.Errorf("Expected Content-Type application/json, got %s", ct)
+	}
+
+	var got struct {
+		ID     float64          `json:"id"`
+		Result InitializeResult `json:"result"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&got); err != nil {
+		t.Fatal(err)
+	}
+	if got.ID != 1 {
+		t.Errorf("Expected response ID 1, got %v", got.ID)
+	}
+	if got.Result.ProtocolVersion != "2025-03-26" {
+		t.Errorf("Expected protocolVersion to be '2025-03-26', got '%s'", got.Result.ProtocolVersion)
+	}
+}
+
+func TestHTTPHandler_Sessions(t *testing.T) {
+	ts := httptest.NewServer(NewServer("test", io.Discard, io.Discard).HTTPHandler())
+	defer ts.Close()
+	url := ts.URL + "/mcp"
+
+	first := initializeSession(t, url)
+	second := initializeSession(t, url)
+	if first == second {
+		t.Fatalf("Expected different session IDs, got %s twice", first)
+	}
+
+	toolsList := `{"jsonrpc": "2.0", "method": "tools/list", "id": 2}`
+	tests := []struct {
+		name       string
+		body       string
+		header     map[string]string
+		wantStatus int
+	}{
+		{
+			name:       "request in the first session",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: first},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "request in the second session with the protocol version",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: second, protocolVersionHeader: "2025-03-26"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "notification",
+			body:       `{"jsonrpc": "2.0", "method": "notifications/initialized"}`,
+			header:     map[string]string{sessionIDHeader: first},
+			wantStatus: http.StatusAccepted,
+		},
+		{
+			name:       "missing session",
+			body:       toolsList,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "unknown session",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: "unknown"},
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "different protocol version",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: first, protocolVersionHeader: "2024-11-05"},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "invalid JSON",
+			body:       `{"jsonrpc": `,
+			header:     map[string]string{sessionIDHeader: first},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "forbidden origin",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: first, "Origin": "https://example.com"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "loopback origin",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: first, "Origin": "http://127.0.0.1:3000"},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "forbidden host without origin",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: first, "Host": "attacker.example.com:8080"},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "localhost host",
+			body:       toolsList,
+			header:     map[string]string{sessionIDHeader: first, "Host": "localhost:8080"},
+			wantStatus: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := postMCP(t, url, tt.body, tt.header)
+			if resp.StatusCode != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d", tt.wantStatus, resp.StatusCode)
+			}
+		})
+	}
+}
+
+func TestHTTPHandler_DeleteSession(t *testing.T) {
+	ts := httptest.NewServer(NewServer("test", io.Discard, io.Discard).HTTPHandler())
+	defer ts.Close()
+	url := ts.URL + "/mcp"
+
+	sessionID := initializeSession(t, url)
+
+	req, _ := http.NewRequest(http.MethodDelete, url, nil)
+	req.Header.Set(sessionIDHeader, sessionID)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent {
+		t.Errorf("Expected status 204, got %d", resp.StatusCode)
+	}
+
+	resp = postMCP(t, url, `{"jsonrpc": "2.0", "method": "tools/list", "id": 2}`, map[string]string{sessionIDHeader: sessionID})
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404 after the session is deleted, got %d", resp.StatusCode)
+	}
+}
+
+func TestHTTPHandler_SessionIdleTimeout(t *testing.T) {
+	transport := newHTTPTransport(NewServer("test", io.Discard, io.Discard))
+	transport.idleTimeout = 200 * time.Millisecond
+	ts := httptest.NewServer(transport)
+	defer ts.Close()
+
+	toolsList := `{"jsonrpc": "2.0", "method": "tools/list", "id": 2}`
+	expiring := initializeSession(t, ts.URL)
+	active := initializeSession(t, ts.URL)
+	for i := 0; i < 6; i++ {
+		time.Sleep(50 * time.Millisecond)
+		resp := postMCP(t, ts.URL, toolsList, map[string]string{sessionIDHeader: active})
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("Expected status 200 while the session is used, got %d", resp.StatusCode)
+		}
+	}
+
+	resp := postMCP(t, ts.URL, toolsList, map[string]string{sessionIDHeader: expiring})
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected status 404 after the session is idle, got %d", resp.StatusCode)
+	}
+
+	// A new session drops the expired ones
+	time.Sleep(250 * time.Millisecond)
+	_ = initializeSession(t, ts.URL)
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	if len(transport.sessions) != 1 {
+		t.Errorf("Expected only the new session, got %d sessions", len(transport.sessions))
+	}
+}
+
+func TestServer_RunHTTP_NonLoopback(t *testing.T) {
+	for _, addr := range []string{":0", "0.0.0.0:0", "example.com:8080"} {
+		t.Run(addr, func(t *testing.T) {
+			var stderr strings.Builder
+			if got := NewServer("test", io.Discard, &stderr).RunHTTP(addr); got != osutil.ExitInternalFailure {
+				t.Errorf("Expected exit code %d, got %d", osutil.ExitInternalFailure, got)
+			}
+			if !strings.Contains(stderr.String(), "loopback") {
+				t.Errorf("Expected the error about the loopback address, got %s", stderr.String())
+			}
+		})
+	}
+}
+
+func TestHTTPHandler_Get(t *testing.T) {
+	ts := httptest.NewServer(NewServer("test", io.Discard, io.Discard).HTTPHandler())
+	defer ts.Close()
+
+	resp, err := http.Get(ts.URL + "/mcp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("Expected status 405, got %d", resp.StatusCode)
+	}
+}
@@ -0,0 +1,155 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// defaultRequestTimeout is the time limit of a request unless SetRequestTimeout changes it
+const defaultRequestTimeout = 5 * time.Minute
+
+var (
+	// errRequestCancelled is the cause of the context of a request cancelled by the client
+	errRequestCancelled = errors.New("request cancelled")
+	// errRequestTimeout is the cause of the context of a request which exceeds the timeout
+	errRequestTimeout = errors.New("request timed out")
+)
+
+// inflightRequests tracks the requests being handled so that the client can cancel them
+type inflightRequests struct {
+	mu      sync.Mutex
+	cancels map[interface{}]context.CancelCauseFunc
+}
+
+func newInflightRequests() *inflightRequests {
+	return &inflightRequests{
+		cancels: make(map[interface{}]context.CancelCauseFunc),
+	}
+}
+
+func (r *inflightRequests) add(id interface{}, cancel context.CancelCauseFunc) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cancels[id] = cancel
+}
+
+func (r *inflightRequests) remove(id interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.cancels, id)
+}
+
+// cancel cancels the request with the id. It does nothing if the request has already finished.
+func (r *inflightRequests) cancel(id interface{}) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	cancel, ok := r.cancels[id]
+	if ok {
+		cancel(errRequestCancelled)
+	}
+	return ok
+}
+
+// notifyFunc sends a notification to the client
+type notifyFunc func(method string, params interface{})
+
+type notifyKey struct{}
+
+type progressKey struct{}
+
+// withNotify returns the context through which the handlers send notifications with notify
+func withNotify(ctx context.Context, notify notifyFunc) context.Context {
+	if notify == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, notifyKey{}, notify)
+}
+
+// withProgressToken returns the context in which reportProgress notifies the client of the progress with the token.
+// No progress is notified after stop returns, so that it never follows the response.
+func withProgressToken(ctx context.Context, token interface{}) (_ context.Context, stop func()) {
+	notify, ok := ctx.Value(notifyKey{}).(notifyFunc)
+	if !ok || token == nil {
+		return ctx, func() {}
+	}
+
+	var mu sync.Mutex
+	stopped := false
+	ctx = context.WithValue(ctx, progressKey{}, func(progress, total int, message string) {
+		mu.Lock()
+		defer mu.Unlock()
+		if stopped {
+			return
+		}
+		notify("notifications/progress", ProgressParams{
+			ProgressToken: token,
+			Progress:      progress,
+			Total:         total,
+			Message:       message,
+		})
+	})
+	return ctx, func() {
+		mu.Lock()
+		defer mu.Unlock()
+		stopped = true
+	}
+}
+
+// reportProgress notifies the client of the progress of the request if the client asked for it.
+// The progress must increase with each call.
+func reportProgress(ctx context.Context, progress, total int, message string) {
+	if report, ok := ctx.Value(progressKey{}).(func(int, int, string)); ok {
+		report(progress, total, message)
+	}
+}
+
+// SetRequestTimeout sets the time limit of each request. Zero means no limit.
+func (s *Server) SetRequestTimeout(timeout time.Duration) {
+	s.requestTimeout = timeout
+}
+
+// handleMessage handles a message from the client with the timeout, keeping track of it in inflight
+// so that notifications/cancelled can cancel it. The handlers send notifications with notify, which can be nil.
+// It returns nil if no response should be sent.
+func (s *Server) handleMessage(
+	ctx context.Context,
+	inflight *inflightRequests,
+	req *Request,
+	notify notifyFunc,
+) *Response {
+	if req.Method == "notifications/cancelled" {
+		var params CancelledParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			_, _ = fmt.Fprintf(s.stderr, "Warning: failed to parse cancelled params: %v\n", err)
+			return nil
+		}
+		// The IDs in the requests are decoded as float64 or string, and so are the ones here.
+		if inflight.cancel(params.RequestID) {
+			_, _ = fmt.Fprintf(s.stderr, "Cancelled request %v: %s\n", params.RequestID, params.Reason)
+		}
+		return nil
+	}
+
+	if s.requestTimeout > 0 {
+		var cancelTimeout context.CancelFunc
+		ctx, cancelTimeout = context.WithTimeoutCause(ctx, s.requestTimeout, errRequestTimeout)
+		defer cancelTimeout()
+	}
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+	if req.ID != nil {
+		inflight.add(req.ID, cancel)
+		defer inflight.remove(req.ID)
+	}
+
+	response := s.handleRequest(withNotify(ctx, notify), req)
+	if errors.Is(context.Cause(ctx), errRequestCancelled) {
+		// The client doesn't expect a response for the cancelled request
+		return nil
+	}
+	return response
+}
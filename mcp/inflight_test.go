@@ -0,0 +1,345 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maramkhaledn/protolint/internal/libinternal"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+)
+
+// blockingTool blocks until release is closed or the request is done
+type blockingTool struct {
+	started chan struct{}
+	release chan struct{}
+	stopped chan error
+}
+
+func newBlockingTool() *blockingTool {
+	return &blockingTool{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+		stopped: make(chan error, 1),
+	}
+}
+
+func (t *blockingTool) GetInfo() ToolInfo {
+	return ToolInfo{Name: "block"}
+}
+
+func (t *blockingTool) Execute(ctx context.Context, _ json.RawMessage) (any, error) {
+	close(t.started)
+	select {
+	case <-t.release:
+		return "released", nil
+	case <-ctx.Done():
+		t.stopped <- context.Cause(ctx)
+		return nil, context.Cause(ctx)
+	}
+}
+
+// pipeClient drives the server through in-memory pipes like a client over stdio
+type pipeClient struct {
+	stdin    *io.PipeWriter
+	messages chan map[string]any
+	exitCode chan osutil.ExitCode
+}
+
+func startPipeServer(t *testing.T, server *Server) *pipeClient {
+	t.Helper()
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+	server.stdout = stdoutWriter
+
+	c := &pipeClient{
+		stdin:    stdinWriter,
+		messages: make(chan map[string]any, 16),
+		exitCode: make(chan osutil.ExitCode, 1),
+	}
+	go func() {
+		c.exitCode <- server.Serve(stdinReader)
+		_ = stdoutWriter.Close()
+	}()
+	go func() {
+		defer close(c.messages)
+		decoder := json.NewDecoder(stdoutReader)
+		for {
+			var message map[string]any
+			if err := decoder.Decode(&message); err != nil {
+				return
+			}
+			c.messages <- message
+		}
+	}()
+	t.Cleanup(func() { _ = stdinWriter.Close() })
+	return c
+}
+
+func (c *pipeClient) send(t *testing.T, message string) {
+	t.Helper()
+	if _, err := io.WriteString(c.stdin, message+"\n"); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func (c *pipeClient) receive(t *testing.T) map[string]any {
+	t.Helper()
+	select {
+	case message, ok := <-c.messages:
+		if !ok {
+			t.Fatal("Expected a message, got the closed stdout")
+		}
+		return message
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for a message")
+	}
+	return nil
+}
+
+// close closes stdin and returns the exit code and the messages sent until the server stops
+func (c *pipeClient) close(t *testing.T) (osutil.ExitCode, []map[string]any) {
+	t.Helper()
+	_ = c.stdin.Close()
+	var rest []map[string]any
+	for message := range c.messages {
+		rest = append(rest, message)
+	}
+	return <-c.exitCode, rest
+}
+
+func waitFor[T any](t *testing.T, ch <-chan T) T {
+	t.Helper()
+	select {
+	case v := <-ch:
+		return v
+	case <-time.After(5 * time.Second):
+		t.Fatal("Timed out waiting for the tool")
+	}
+	var zero T
+	return zero
+}
+
+const (
+	callBlockingTool = `{"jsonrpc": "2.0", "method": "tools/call", "id": 1, "params": {"name": "block", "arguments": {}}}`
+	listTools        = `{"jsonrpc": "2.0", "method": "tools/list", "id": 2}`
+)
+
+func TestServer_Serve_Concurrent(t *testing.T) {
+	tool := newBlockingTool()
+	server := NewServer("test", io.Discard, io.Discard)
+	server.tools = append(server.tools, tool)
+	client := startPipeServer(t, server)
+
+	client.send(t, callBlockingTool)
+	waitFor(t, tool.started)
+
+	// The blocked request doesn't block the next one
+	client.send(t, listTools)
+	if got := client.receive(t); got["id"] != float64(2) {
+		t.Fatalf("Expected the response to id 2 first, got %v", got)
+	}
+
+	close(tool.release)
+	got := client.receive(t)
+	if got["id"] != float64(1) || got["error"] != nil {
+		t.Errorf("Expected the result of id 1, got %v", got)
+	}
+
+	exitCode, rest := client.close(t)
+	if exitCode != osutil.ExitSuccess {
+		t.Errorf("Expected exit code %d, got %d", osutil.ExitSuccess, exitCode)
+	}
+	if len(rest) != 0 {
+		t.Errorf("Expected no more messages, got %v", rest)
+	}
+}
+
+func TestServer_Serve_Cancelled(t *testing.T) {
+	tool := newBlockingTool()
+	server := NewServer("test", io.Discard, io.Discard)
+	server.tools = append(server.tools, tool)
+	client := startPipeServer(t, server)
+
+	client.send(t, callBlockingTool)
+	waitFor(t, tool.started)
+
+	client.send(t, `{"jsonrpc": "2.0", "method": "notifications/cancelled", "params": {"requestId": 1, "reason": "test"}}`)
+	if err := waitFor(t, tool.stopped); !errors.Is(err, errRequestCancelled) {
+		t.Errorf("Expected the tool to be cancelled, got %v", err)
+	}
+
+	// Cancelling the finished or unknown request does nothing
+	client.send(t, `{"jsonrpc": "2.0", "method": "notifications/cancelled", "params": {"requestId": 3}}`)
+	client.send(t, listTools)
+	if got := client.receive(t); got["id"] != float64(2) {
+		t.Errorf("Expected only the response to id 2, got %v", got)
+	}
+
+	exitCode, rest := client.close(t)
+	if exitCode != osutil.ExitSuccess {
+		t.Errorf("Expected exit code %d, got %d", osutil.ExitSuccess, exitCode)
+	}
+	if len(rest) != 0 {
+		t.Errorf("Expected no response to the cancelled request, got %v", rest)
+	}
+}
+
+func TestServer_Serve_Timeout(t *testing.T) {
+	tool := newBlockingTool()
+	server := NewServer("test", io.Discard, io.Discard)
+	server.tools = append(server.tools, tool)
+	server.SetRequestTimeout(50 * time.Millisecond)
+	client := startPipeServer(t, server)
+
+	client.send(t, callBlockingTool)
+	got := client.receive(t)
+	if got["id"] != float64(1) {
+		t.Fatalf("Expected the response to id 1, got %v", got)
+	}
+	respErr, ok := got["error"].(map[string]any)
+	if !ok || !strings.Contains(respErr["message"].(string), errRequestTimeout.Error()) {
+		t.Errorf("Expected the timeout error, got %v", got["error"])
+	}
+	if err := waitFor(t, tool.stopped); !errors.Is(err, errRequestTimeout) {
+		t.Errorf("Expected the tool to time out, got %v", err)
+	}
+
+	if exitCode, _ := client.close(t); exitCode != osutil.ExitSuccess {
+		t.Errorf("Expected exit code %d, got %d", osutil.ExitSuccess, exitCode)
+	}
+}
+
+// fakeLintRunner reports no failures for each file like the MCP reporter
+type fakeLintRunner struct{}
+
+func (r fakeLintRunner) Run(args []string, stdout, stderr io.Writer) osutil.ExitCode {
+	return r.RunContext(context.Background(), args, stdout, stderr)
+}
+
+func (fakeLintRunner) RunContext(ctx context.Context, args []string, _, stderr io.Writer) osutil.ExitCode {
+	// The files follow --reporter mcp
+	files := args[2:]
+	results := []map[string]any{}
+	for i, file := range files {
+		results = append(results, map[string]any{
+			"file_path": file,
+			"failures":  []any{},
+		})
+		if fileLinted := libinternal.FileLinted(ctx); fileLinted != nil {
+			fileLinted(file, i+1, len(files))
+		}
+	}
+	result, _ := json.Marshal(map[string]any{
+		"results": results,
+	})
+	_, _ = stderr.Write(result)
+	return osutil.ExitSuccess
+}
+
+func TestServer_Serve_Progress(t *testing.T) {
+	runner := libinternal.GetLintRunner()
+	libinternal.SetLintRunner(fakeLintRunner{})
+	t.Cleanup(func() { libinternal.SetLintRunner(runner) })
+
+	client := startPipeServer(t, NewServer("test", io.Discard, io.Discard))
+	client.send(t, `{"jsonrpc": "2.0", "method": "tools/call", "id": 1, "params": {
+		"name": "lint-files",
+		"arguments": {"files": ["a.proto", "b.proto", "c.proto"]},
+		"_meta": {"progressToken": "lint-1"}
+	}}`)
+
+	for i := 1; i <= 3; i++ {
+		got := client.receive(t)
+		if got["method"] != "notifications/progress" {
+			t.Fatalf("Expected notifications/progress, got %v", got)
+		}
+		params := got["params"].(map[string]any)
+		if params["progressToken"] != "lint-1" || params["progress"] != float64(i) || params["total"] != float64(3) {
+			t.Errorf("Expected progress %d of 3 with lint-1, got %v", i, params)
+		}
+	}
+
+	got := client.receive(t)
+	if got["id"] != float64(1) || got["error"] != nil {
+		t.Fatalf("Expected the result of id 1, got %v", got)
+	}
+	text := got["result"].(map[string]any)["content"].([]any)[0].(map[string]any)["text"].(string)
+	var result struct {
+		ExitCode int   `json:"exit_code"`
+		Results  []any `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(text), &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.ExitCode != 0 || len(result.Results) != 3 {
+		t.Errorf("Expected 3 results with exit code 0, got %s", text)
+	}
+
+	// No progress is reported without the token
+	client.send(t, `{"jsonrpc": "2.0", "method": "tools/call", "id": 2, "params": {"name": "lint-files", "arguments": {"files": ["a.proto"]}}}`)
+	if got := client.receive(t); got["id"] != float64(2) {
+		t.Errorf("Expected only the response to id 2, got %v", got)
+	}
+	client.close(t)
+}
+
+// blockingLintRunner lints until the context is done
+type blockingLintRunner struct {
+	fakeLintRunner
+	started chan struct{}
+	stopped chan error
+}
+
+func (r blockingLintRunner) RunContext(ctx context.Context, _ []string, _, _ io.Writer) osutil.ExitCode {
+	close(r.started)
+	<-ctx.Done()
+	r.stopped <- context.Cause(ctx)
+	return osutil.ExitInternalFailure
+}
+
+func TestLintFilesTool_Execute_Cancelled(t *testing.T) {
+	runner := blockingLintRunner{
+		started: make(chan struct{}),
+		stopped: make(chan error, 1),
+	}
+	original := libinternal.GetLintRunner()
+	libinternal.SetLintRunner(runner)
+	t.Cleanup(func() { libinternal.SetLintRunner(original) })
+
+	ctx, cancel := context.WithCancelCause(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		_, err := NewLintFilesTool().Execute(ctx, json.RawMessage(`{"files": ["a.proto", "b.proto"]}`))
+		done <- err
+	}()
+
+	waitFor(t, runner.started)
+	cancel(errRequestCancelled)
+	if err := waitFor(t, runner.stopped); !errors.Is(err, errRequestCancelled) {
+		t.Errorf("Expected the lint to stop with the cancel, got %v", err)
+	}
+	if err := waitFor(t, done); !errors.Is(err, errRequestCancelled) {
+		t.Errorf("Expected the tool to return the cancel, got %v", err)
+	}
+}
+
+func TestWithProgressToken_Stop(t *testing.T) {
+	var notified []string
+	ctx := withNotify(context.Background(), func(method string, _ interface{}) {
+		notified = append(notified, method)
+	})
+	ctx, stop := withProgressToken(ctx, "token")
+
+	reportProgress(ctx, 1, 2, "first")
+	stop()
+	reportProgress(ctx, 2, 2, "after the response")
+
+	if len(notified) != 1 {
+		t.Errorf("Expected only the progress before stop, got %v", notified)
+	}
+}
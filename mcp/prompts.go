@@ -0,0 +1,113 @@
+package mcp
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Prompt defines the interface for MCP prompts
+type Prompt interface {
+	GetInfo() PromptInfo
+	Get(args map[string]string) (*GetPromptResponse, error)
+}
+
+var filePromptArgument = PromptArgument{
+	Name:        "file",
+	Description: "Absolute path of the proto file",
+	Required:    true,
+}
+
+// FixLintFailuresPrompt is a prompt to fix all lint failures in a file
+type FixLintFailuresPrompt struct{}
+
+// NewFixLintFailuresPrompt creates a new FixLintFailuresPrompt
+func NewFixLintFailuresPrompt() *FixLintFailuresPrompt {
+	return &FixLintFailuresPrompt{}
+}
+
+// GetInfo returns the prompt information
+func (p *FixLintFailuresPrompt) GetInfo() PromptInfo {
+	return PromptInfo{
+		Name:        "fix-lint-failures",
+		Description: "Fix all lint failures in a proto file",
+		Arguments:   []PromptArgument{filePromptArgument},
+	}
+}
+
+// Get returns the prompt messages for the file
+func (p *FixLintFailuresPrompt) Get(args map[string]string) (*GetPromptResponse, error) {
+	file := args["file"]
+	if file == "" {
+		return nil, fmt.Errorf("no file specified")
+	}
+
+	text := fmt.Sprintf(`Fix all protolint failures in %s.
+
+1. Call the lint-files tool with {"files": [%q], "fix": true} to let protolint fix what it can.
+2. Call the lint-files tool again without fix to see the remaining failures.
+3. For each remaining failure, call the explain-rule tool with its rule_id if the fix isn't obvious, and edit the file to follow the rule. Keep the field numbers and the wire compatibility unchanged.
+4. Repeat from step 2 until no failures remain, and summarize what you changed.`, file, file)
+
+	return &GetPromptResponse{
+		Description: "Fix all lint failures in " + file,
+		Messages:    []PromptMessage{userTextMessage(text)},
+	}, nil
+}
+
+// ReviewAPIStylePrompt is a prompt to review a proto file for the API style
+type ReviewAPIStylePrompt struct{}
+
+// NewReviewAPIStylePrompt creates a new ReviewAPIStylePrompt
+func NewReviewAPIStylePrompt() *ReviewAPIStylePrompt {
+	return &ReviewAPIStylePrompt{}
+}
+
+// GetInfo returns the prompt information
+func (p *ReviewAPIStylePrompt) GetInfo() PromptInfo {
+	return PromptInfo{
+		Name:        "review-api-style",
+		Description: "Review a proto file for the API style, with the rules enabled for the file",
+		Arguments:   []PromptArgument{filePromptArgument},
+	}
+}
+
+// Get returns the prompt messages for the file
+func (p *ReviewAPIStylePrompt) Get(args map[string]string) (*GetPromptResponse, error) {
+	file := args["file"]
+	if file == "" {
+		return nil, fmt.Errorf("no file specified")
+	}
+
+	resolved, err := resolveRules("", file, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "Review %s for the API style.\n\n", file)
+	fmt.Fprintf(&b, "Call the lint-files tool with {\"files\": [%q]} first. ", file)
+	b.WriteString("Then look for the problems which the linter can't find, like unclear names, missing documentation of the behavior, ")
+	b.WriteString("fields which should be messages, and changes which break the wire compatibility.\n\n")
+	b.WriteString("protolint enforces these rules for the file:\n\n")
+	for _, s := range resolved.summaries() {
+		if s.Enabled {
+			fmt.Fprintf(&b, "- %s (%s): %s\n", s.ID, s.Severity, s.Purpose)
+		}
+	}
+	b.WriteString("\nReport each problem with its line and a suggested change, most important first. Don't edit the file.")
+
+	return &GetPromptResponse{
+		Description: "Review " + file + " for the API style",
+		Messages:    []PromptMessage{userTextMessage(b.String())},
+	}, nil
+}
+
+func userTextMessage(text string) PromptMessage {
+	return PromptMessage{
+		Role: "user",
+		Content: ContentItem{
+			Type: "text",
+			Text: text,
+		},
+	}
+}
@@ -0,0 +1,419 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/linter/symbol"
+)
+
+// OutlineFileTool is a tool for outlining the structure of a Proto file
+type OutlineFileTool struct{}
+
+// NewOutlineFileTool creates a new OutlineFileTool
+func NewOutlineFileTool() *OutlineFileTool {
+	return &OutlineFileTool{}
+}
+
+// GetInfo returns the tool information
+func (t *OutlineFileTool) GetInfo() ToolInfo {
+	return ToolInfo{
+		Name:        "outline-file",
+		Description: "Outline a Protocol Buffer file as JSON: the package, imports, options, messages with their fields and numbers, enums, and services with their RPCs and streaming flags",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"file": map[string]any{
+					"type":        "string",
+					"description": "Path of the proto file. The path must be absolute.",
+				},
+			},
+			"required": []string{"file"},
+		},
+	}
+}
+
+// OutlineFileArgs represents arguments for outline-file tool
+type OutlineFileArgs struct {
+	File string `json:"file"`
+}
+
+// FileOutline is the result of outline-file tool
+type FileOutline struct {
+	File     string           `json:"file"`
+	Syntax   string           `json:"syntax,omitempty"`
+	Edition  string           `json:"edition,omitempty"`
+	Package  string           `json:"package,omitempty"`
+	Imports  []ImportOutline  `json:"imports,omitempty"`
+	Options  []OptionOutline  `json:"options,omitempty"`
+	Messages []MessageOutline `json:"messages,omitempty"`
+	Enums    []EnumOutline    `json:"enums,omitempty"`
+	Services []ServiceOutline `json:"services,omitempty"`
+}
+
+// ImportOutline represents an import statement
+type ImportOutline struct {
+	Path string `json:"path"`
+	// Modifier is "public", "weak" or empty.
+	Modifier string `json:"modifier,omitempty"`
+	Line     int    `json:"line"`
+}
+
+// OptionOutline represents an option statement
+type OptionOutline struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+	Line  int    `json:"line"`
+}
+
+// MessageOutline represents a message with its nested types
+type MessageOutline struct {
+	Name     string           `json:"name"`
+	FullName string           `json:"full_name"`
+	Line     int              `json:"line"`
+	Fields   []FieldOutline   `json:"fields,omitempty"`
+	Messages []MessageOutline `json:"messages,omitempty"`
+	Enums    []EnumOutline    `json:"enums,omitempty"`
+	Options  []OptionOutline  `json:"options,omitempty"`
+}
+
+// FieldOutline represents a field of a message
+type FieldOutline struct {
+	Name string `json:"name"`
+	// Type is the type as written, like string, foo.v1.Bar or map<string, Bar>.
+	Type   string `json:"type"`
+	Number int    `json:"number"`
+	// Label is "repeated", "optional", "required" or empty.
+	Label string `json:"label,omitempty"`
+	// Oneof is the name of the oneof which has the field.
+	Oneof string `json:"oneof,omitempty"`
+	Line  int    `json:"line"`
+}
+
+// EnumOutline represents an enum
+type EnumOutline struct {
+	Name     string             `json:"name"`
+	FullName string             `json:"full_name"`
+	Line     int                `json:"line"`
+	Values   []EnumValueOutline `json:"values,omitempty"`
+}
+
+// EnumValueOutline represents a value of an enum
+type EnumValueOutline struct {
+	Name   string `json:"name"`
+	Number int    `json:"number"`
+	Line   int    `json:"line"`
+}
+
+// ServiceOutline represents a service
+type ServiceOutline struct {
+	Name string       `json:"name"`
+	Line int          `json:"line"`
+	RPCs []RPCOutline `json:"rpcs,omitempty"`
+}
+
+// RPCOutline represents an RPC of a service
+type RPCOutline struct {
+	Name            string `json:"name"`
+	Request         string `json:"request"`
+	Response        string `json:"response"`
+	ClientStreaming bool   `json:"client_streaming"`
+	ServerStreaming bool   `json:"server_streaming"`
+	Line            int    `json:"line"`
+}
+
+// Execute runs the outline-file tool
+func (t *OutlineFileTool) Execute(ctx context.Context, args json.RawMessage) (any, error) {
+	var outlineArgs OutlineFileArgs
+	if err := json.Unmarshal(args, &outlineArgs); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %v", err)
+	}
+	if outlineArgs.File == "" {
+		return nil, fmt.Errorf("no file specified")
+	}
+
+	proto, err := file.NewProtoFile(outlineArgs.File, outlineArgs.File).Parse(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", outlineArgs.File, err)
+	}
+	return outlineProto(outlineArgs.File, proto), nil
+}
+
+func outlineProto(path string, proto *parser.Proto) FileOutline {
+	outline := FileOutline{
+		File:    path,
+		Package: symbol.PackageName(proto),
+	}
+	if proto.Syntax != nil {
+		outline.Syntax = proto.Syntax.ProtobufVersion
+	}
+	if proto.Edition != nil {
+		outline.Edition = proto.Edition.Edition
+	}
+
+	for _, body := range proto.ProtoBody {
+		switch b := body.(type) {
+		case *parser.Import:
+			imp := ImportOutline{
+				Path: symbol.ImportPath(b),
+				Line: b.Meta.Pos.Line,
+			}
+			switch b.Modifier {
+			case parser.ImportModifierPublic:
+				imp.Modifier = "public"
+			case parser.ImportModifierWeak:
+				imp.Modifier = "weak"
+			}
+			outline.Imports = append(outline.Imports, imp)
+		case *parser.Option:
+			outline.Options = append(outline.Options, outlineOption(b))
+		case *parser.Message:
+			outline.Messages = append(outline.Messages, outlineMessage(outline.Package, b.MessageName, b.MessageBody, b.Meta.Pos.Line))
+		case *parser.Enum:
+			outline.Enums = append(outline.Enums, outlineEnum(outline.Package, b))
+		case *parser.Service:
+			outline.Services = append(outline.Services, outlineService(b))
+		}
+	}
+	return outline
+}
+
+func outlineOption(option *parser.Option) OptionOutline {
+	return OptionOutline{
+		Name:  option.OptionName,
+		Value: option.Constant,
+		Line:  option.Meta.Pos.Line,
+	}
+}
+
+func outlineMessage(scope, name string, bodies []parser.Visitee, line int) MessageOutline {
+	fullName := qualifyName(scope, name)
+	message := MessageOutline{
+		Name:     name,
+		FullName: fullName,
+		Line:     line,
+	}
+	for _, body := range bodies {
+		switch b := body.(type) {
+		case *parser.Field:
+			message.Fields = append(message.Fields, FieldOutline{
+				Name:   b.FieldName,
+				Type:   b.Type,
+				Number: parseNumber(b.FieldNumber),
+				Label:  fieldLabel(b.IsRepeated, b.IsOptional, b.IsRequired),
+				Line:   b.Meta.Pos.Line,
+			})
+		case *parser.MapField:
+			message.Fields = append(message.Fields, FieldOutline{
+				Name:   b.MapName,
+				Type:   fmt.Sprintf("map<%s, %s>", b.KeyType, b.Type),
+				Number: parseNumber(b.FieldNumber),
+				Line:   b.Meta.Pos.Line,
+			})
+		case *parser.Oneof:
+			for _, field := range b.OneofFields {
+				message.Fields = append(message.Fields, FieldOutline{
+					Name:   field.FieldName,
+					Type:   field.Type,
+					Number: parseNumber(field.FieldNumber),
+					Oneof:  b.OneofName,
+					Line:   field.Meta.Pos.Line,
+				})
+			}
+		case *parser.GroupField:
+			message.Fields = append(message.Fields, FieldOutline{
+				Name:   b.GroupName,
+				Type:   "group",
+				Number: parseNumber(b.FieldNumber),
+				Label:  fieldLabel(b.IsRepeated, b.IsOptional, b.IsRequired),
+				Line:   b.Meta.Pos.Line,
+			})
+			message.Messages = append(message.Messages, outlineMessage(fullName, b.GroupName, b.MessageBody, b.Meta.Pos.Line))
+		case *parser.Message:
+			message.Messages = append(message.Messages, outlineMessage(fullName, b.MessageName, b.MessageBody, b.Meta.Pos.Line))
+		case *parser.Enum:
+			message.Enums = append(message.Enums, outlineEnum(fullName, b))
+		case *parser.Option:
+			message.Options = append(message.Options, outlineOption(b))
+		}
+	}
+	return message
+}
+
+func outlineEnum(scope string, enum *parser.Enum) EnumOutline {
+	outline := EnumOutline{
+		Name:     enum.EnumName,
+		FullName: qualifyName(scope, enum.EnumName),
+		Line:     enum.Meta.Pos.Line,
+	}
+	for _, body := range enum.EnumBody {
+		if value, ok := body.(*parser.EnumField); ok {
+			outline.Values = append(outline.Values, EnumValueOutline{
+				Name:   value.Ident,
+				Number: parseNumber(value.Number),
+				Line:   value.Meta.Pos.Line,
+			})
+		}
+	}
+	return outline
+}
+
+func outlineService(service *parser.Service) ServiceOutline {
+	outline := ServiceOutline{
+		Name: service.ServiceName,
+		Line: service.Meta.Pos.Line,
+	}
+	for _, body := range service.ServiceBody {
+		if rpc, ok := body.(*parser.RPC); ok {
+			outline.RPCs = append(outline.RPCs, RPCOutline{
+				Name:            rpc.RPCName,
+				Request:         rpc.RPCRequest.MessageType,
+				Response:        rpc.RPCResponse.MessageType,
+				ClientStreaming: rpc.RPCRequest.IsStream,
+				ServerStreaming: rpc.RPCResponse.IsStream,
+				Line:            rpc.Meta.Pos.Line,
+			})
+		}
+	}
+	return outline
+}
+
+func fieldLabel(isRepeated, isOptional, isRequired bool) string {
+	switch {
+	case isRepeated:
+		return "repeated"
+	case isOptional:
+		return "optional"
+	case isRequired:
+		return "required"
+	}
+	return ""
+}
+
+// parseNumber parses a field or enum value number, which can be hexadecimal or octal
+func parseNumber(s string) int {
+	n, _ := strconv.ParseInt(s, 0, 64)
+	return int(n)
+}
+
+func qualifyName(scope, name string) string {
+	if scope == "" {
+		return name
+	}
+	return scope + "." + name
+}
+
+// FindDefinitionTool is a tool for finding where a type referenced in a Proto file is defined
+type FindDefinitionTool struct{}
+
+// NewFindDefinitionTool creates a new FindDefinitionTool
+func NewFindDefinitionTool() *FindDefinitionTool {
+	return &FindDefinitionTool{}
+}
+
+// GetInfo returns the tool information
+func (t *FindDefinitionTool) GetInfo() ToolInfo {
+	return ToolInfo{
+		Name:        "find-definition",
+		Description: "Find where a message or enum type referenced in a Protocol Buffer file is defined, in the file itself or in the files it imports from the import paths",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"file": map[string]any{
+					"type":        "string",
+					"description": "Path of the proto file which references the type. The path must be absolute.",
+				},
+				"type": map[string]any{
+					"type":        "string",
+					"description": "Type name as written in the file, like Bar, foo.v1.Bar or .foo.v1.Bar. It is resolved from the scope where the file references it first.",
+				},
+				"import_paths": map[string]any{
+					"type": "array",
+					"items": map[string]any{
+						"type": "string",
+					},
+					"description": "Directories where the imports are searched for in order, like protoc -I. Default is the current directory and the directory of the file.",
+				},
+			},
+			"required": []string{"file", "type"},
+		},
+	}
+}
+
+// FindDefinitionArgs represents arguments for find-definition tool
+type FindDefinitionArgs struct {
+	File        string   `json:"file"`
+	Type        string   `json:"type"`
+	ImportPaths []string `json:"import_paths,omitempty"`
+}
+
+// DefinitionLocation is the result of find-definition tool
+type DefinitionLocation struct {
+	Type     string `json:"type"`
+	FullName string `json:"full_name"`
+	// Kind is "message" or "enum".
+	Kind   string `json:"kind"`
+	File   string `json:"file"`
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+}
+
+// Execute runs the find-definition tool
+func (t *FindDefinitionTool) Execute(ctx context.Context, args json.RawMessage) (any, error) {
+	var findArgs FindDefinitionArgs
+	if err := json.Unmarshal(args, &findArgs); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %v", err)
+	}
+	if findArgs.File == "" {
+		return nil, fmt.Errorf("no file specified")
+	}
+	if findArgs.Type == "" {
+		return nil, fmt.Errorf("no type specified")
+	}
+
+	proto, err := file.NewProtoFile(findArgs.File, findArgs.File).Parse(false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %v", findArgs.File, err)
+	}
+
+	importPaths := findArgs.ImportPaths
+	if len(importPaths) == 0 {
+		importPaths = []string{".", filepath.Dir(findArgs.File)}
+	}
+	table, _ := symbol.NewImporter(importPaths).Table(proto)
+
+	// Resolve the type from the scope of the first reference, or from the package if the file doesn't reference it.
+	scope := symbol.PackageName(proto)
+	for _, ref := range symbol.References(proto) {
+		if ref.Name == findArgs.Type {
+			scope = ref.Scope
+			break
+		}
+	}
+
+	def, ok := table.Resolve(scope, findArgs.Type)
+	if !ok {
+		return nil, fmt.Errorf("%s is defined neither in %s nor in the files it imports from the import paths %v",
+			findArgs.Type, findArgs.File, importPaths)
+	}
+
+	// The well-known files bundled with protoc are named after their import paths.
+	path := def.Pos.Filename
+	if abs, err := filepath.Abs(path); err == nil && !symbol.IsWellKnown(path) {
+		path = abs
+	}
+	return DefinitionLocation{
+		Type:     findArgs.Type,
+		FullName: def.FullName,
+		Kind:     string(def.Kind),
+		File:     path,
+		Line:     def.Pos.Line,
+		Column:   def.Pos.Column,
+	}, nil
+}
@@ -0,0 +1,240 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/setting_test"
+)
+
+func TestOutlineFileTool_Execute(t *testing.T) {
+	path := setting_test.TestDataPath("imports", "foo", "v1", "foo.proto")
+	args, _ := json.Marshal(OutlineFileArgs{File: path})
+
+	result, err := NewOutlineFileTool().Execute(context.Background(), args)
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	got := result.(FileOutline)
+	want := FileOutline{
+		File:    path,
+		Syntax:  "proto3",
+		Package: "foo.v1",
+		Imports: []ImportOutline{
+			{Path: "bar/v1/bar.proto", Line: 5},
+			{Path: "missing/v1/missing.proto", Line: 6},
+		},
+		Options: []OptionOutline{
+			{Name: "go_package", Value: `"example.com/foo/v1;foov1"`, Line: 8},
+		},
+		Messages: []MessageOutline{
+			{
+				Name:     "Foo",
+				FullName: "foo.v1.Foo",
+				Line:     10,
+				Fields: []FieldOutline{
+					{Name: "inner", Type: "Inner", Number: 1, Line: 20},
+					{Name: "bar", Type: "bar.v1.Bar", Number: 2, Line: 21},
+					{Name: "nested", Type: ".bar.v1.Bar.Nested", Number: 3, Line: 22},
+					{Name: "quxes", Type: "map<string, qux.v1.Qux>", Number: 4, Line: 23},
+					{Name: "names", Type: "string", Number: 5, Label: "repeated", Line: 24},
+					{Name: "number", Type: "int64", Number: 6, Oneof: "value", Line: 26},
+					{Name: "kind", Type: "Kind", Number: 7, Oneof: "value", Line: 27},
+				},
+				Messages: []MessageOutline{
+					{
+						Name:     "Inner",
+						FullName: "foo.v1.Foo.Inner",
+						Line:     11,
+						Fields: []FieldOutline{
+							{Name: "kind", Type: "Kind", Number: 1, Line: 12},
+						},
+					},
+				},
+				Enums: []EnumOutline{
+					{
+						Name:     "Kind",
+						FullName: "foo.v1.Foo.Kind",
+						Line:     15,
+						Values: []EnumValueOutline{
+							{Name: "KIND_UNSPECIFIED", Number: 0, Line: 16},
+							{Name: "KIND_SIMPLE", Number: 1, Line: 17},
+						},
+					},
+				},
+			},
+		},
+		Services: []ServiceOutline{
+			{
+				Name: "FooService",
+				Line: 31,
+				RPCs: []RPCOutline{
+					{Name: "GetFoo", Request: "Foo", Response: "Foo", Line: 32},
+					{Name: "WatchBars", Request: "Foo", Response: "bar.v1.Bar", ClientStreaming: true, ServerStreaming: true, Line: 33},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		gotJSON, _ := json.MarshalIndent(got, "", "  ")
+		t.Errorf("Expected the outline %+v, got %s", want, gotJSON)
+	}
+}
+
+func TestOutlineFileTool_Execute_InvalidArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+	}{
+		{
+			name: "invalid JSON",
+			args: `{"file": `,
+		},
+		{
+			name: "missing file",
+			args: `{}`,
+		},
+		{
+			name: "not found file",
+			args: `{"file": "/not/found.proto"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewOutlineFileTool().Execute(context.Background(), json.RawMessage(tt.args))
+			if err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestFindDefinitionTool_Execute(t *testing.T) {
+	root := setting_test.TestDataPath("imports")
+	path := filepath.Join(root, "foo", "v1", "foo.proto")
+
+	tests := []struct {
+		name        string
+		typeName    string
+		importPaths []string
+		want        DefinitionLocation
+		wantErr     bool
+	}{
+		{
+			name:     "nested type in the file",
+			typeName: "Inner",
+			want: DefinitionLocation{
+				Type:     "Inner",
+				FullName: "foo.v1.Foo.Inner",
+				Kind:     "message",
+				File:     path,
+				Line:     11,
+				Column:   3,
+			},
+		},
+		{
+			name:     "enum referenced from the nested message",
+			typeName: "Kind",
+			want: DefinitionLocation{
+				Type:     "Kind",
+				FullName: "foo.v1.Foo.Kind",
+				Kind:     "enum",
+				File:     path,
+				Line:     15,
+				Column:   3,
+			},
+		},
+		{
+			name:        "type in the imported file",
+			typeName:    ".bar.v1.Bar.Nested",
+			importPaths: []string{root},
+			want: DefinitionLocation{
+				Type:     ".bar.v1.Bar.Nested",
+				FullName: "bar.v1.Bar.Nested",
+				Kind:     "message",
+				File:     filepath.Join(root, "bar", "v1", "bar.proto"),
+				Line:     8,
+				Column:   3,
+			},
+		},
+		{
+			name:        "type in the file imported publicly",
+			typeName:    "qux.v1.Qux",
+			importPaths: []string{root},
+			want: DefinitionLocation{
+				Type:     "qux.v1.Qux",
+				FullName: "qux.v1.Qux",
+				Kind:     "message",
+				File:     filepath.Join(root, "qux", "v1", "qux.proto"),
+				Line:     5,
+				Column:   1,
+			},
+		},
+		{
+			name:     "imported type without the import paths",
+			typeName: "bar.v1.Bar",
+			wantErr:  true,
+		},
+		{
+			name:        "undefined type",
+			typeName:    "Baz",
+			importPaths: []string{root},
+			wantErr:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, _ := json.Marshal(FindDefinitionArgs{
+				File:        path,
+				Type:        tt.typeName,
+				ImportPaths: tt.importPaths,
+			})
+			result, err := NewFindDefinitionTool().Execute(context.Background(), args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got := result.(DefinitionLocation); got != tt.want {
+				t.Errorf("Expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestFindDefinitionTool_Execute_InvalidArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+	}{
+		{
+			name: "invalid JSON",
+			args: `{"file": `,
+		},
+		{
+			name: "missing file",
+			args: `{"type": "Foo"}`,
+		},
+		{
+			name: "missing type",
+			args: `{"file": "/foo.proto"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewFindDefinitionTool().Execute(context.Background(), json.RawMessage(tt.args))
+			if err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
@@ -21,6 +21,13 @@ type Response struct {
 	ID      interface{} `json:"id"`
 }
 
+// Notification represents a JSON-RPC 2.0 notification sent by the server
+type Notification struct {
+	JSONRPC string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
 // Error represents a JSON-RPC 2.0 error
 type Error struct {
 	Code    int         `json:"code"`
@@ -87,6 +94,27 @@ type ToolInfo struct {
 type CallToolPayload struct {
 	Name      string          `json:"name"`
 	Arguments json.RawMessage `json:"arguments"`
+	Meta      *RequestMeta    `json:"_meta,omitempty"`
+}
+
+// RequestMeta represents the metadata attached to a request
+type RequestMeta struct {
+	// ProgressToken is a string or a number. The client receives notifications/progress with it if set.
+	ProgressToken interface{} `json:"progressToken,omitempty"`
+}
+
+// ProgressParams represents the parameters for notifications/progress
+type ProgressParams struct {
+	ProgressToken interface{} `json:"progressToken"`
+	Progress      int         `json:"progress"`
+	Total         int         `json:"total,omitempty"`
+	Message       string      `json:"message,omitempty"`
+}
+
+// CancelledParams represents the parameters for notifications/cancelled
+type CancelledParams struct {
+	RequestID interface{} `json:"requestId"`
+	Reason    string      `json:"reason,omitempty"`
 }
 
 // ContentItem represents a content item in a tool result
@@ -103,3 +131,70 @@ type CallToolResponse struct {
 	Content []ContentItem `json:"content"`
 	IsError bool          `json:"isError"`
 }
+
+// ResourceInfo represents information about a resource
+type ResourceInfo struct {
+	URI         string `json:"uri"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	MimeType    string `json:"mimeType,omitempty"`
+}
+
+// ListResourcesResponse represents the response for resources/list request
+type ListResourcesResponse struct {
+	Resources []ResourceInfo `json:"resources"`
+}
+
+// ReadResourcePayload represents the payload for resources/read request
+type ReadResourcePayload struct {
+	URI string `json:"uri"`
+}
+
+// ResourceContent represents the content of a resource
+type ResourceContent struct {
+	URI      string `json:"uri"`
+	MimeType string `json:"mimeType,omitempty"`
+	Text     string `json:"text"`
+}
+
+// ReadResourceResponse represents the response for resources/read request
+type ReadResourceResponse struct {
+	Contents []ResourceContent `json:"contents"`
+}
+
+// PromptArgument represents an argument of a prompt
+type PromptArgument struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+}
+
+// PromptInfo represents information about a prompt
+type PromptInfo struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Arguments   []PromptArgument `json:"arguments,omitempty"`
+}
+
+// ListPromptsResponse represents the response for prompts/list request
+type ListPromptsResponse struct {
+	Prompts []PromptInfo `json:"prompts"`
+}
+
+// GetPromptPayload represents the payload for prompts/get request
+type GetPromptPayload struct {
+	Name      string            `json:"name"`
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+// PromptMessage represents a message of a prompt
+type PromptMessage struct {
+	Role    string      `json:"role"`
+	Content ContentItem `json:"content"`
+}
+
+// GetPromptResponse represents the response for prompts/get request
+type GetPromptResponse struct {
+	Description string          `json:"description,omitempty"`
+	Messages    []PromptMessage `json:"messages"`
+}
@@ -0,0 +1,145 @@
+package mcp
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+const (
+	configResourceURI     = "protolint://config"
+	ruleResourceURIPrefix = "protolint://rules/"
+)
+
+// errResourceNotFound is returned for the unknown resource URIs
+var errResourceNotFound = errors.New("resource not found")
+
+// EffectiveConfig is the content of the config resource
+type EffectiveConfig struct {
+	// ConfigPath is empty if no config file is found.
+	ConfigPath string `json:"config_path"`
+	// Source is the content of the config file as it is.
+	Source string        `json:"source,omitempty"`
+	Rules  []RuleSummary `json:"rules"`
+}
+
+// listResources lists the effective config and the documentation of each built-in rule
+func listResources() ([]ResourceInfo, error) {
+	resources := []ResourceInfo{
+		{
+			URI:         configResourceURI,
+			Name:        "protolint config",
+			Description: "The config file in the current directory and the rules enabled under it, with the environment variables applied",
+			MimeType:    "application/json",
+		},
+	}
+
+	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, visitor.Env{}, false, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range allRules {
+		resources = append(resources, ResourceInfo{
+			URI:         ruleResourceURIPrefix + r.ID(),
+			Name:        r.ID(),
+			Description: r.Purpose(),
+			MimeType:    "text/markdown",
+		})
+	}
+	return resources, nil
+}
+
+// readResource reads the resource. It returns errResourceNotFound for the unknown URIs.
+func readResource(uri string) (ResourceContent, error) {
+	switch {
+	case uri == configResourceURI:
+		text, err := effectiveConfigJSON()
+		if err != nil {
+			return ResourceContent{}, err
+		}
+		return ResourceContent{
+			URI:      uri,
+			MimeType: "application/json",
+			Text:     text,
+		}, nil
+	case strings.HasPrefix(uri, ruleResourceURIPrefix):
+		explanation, err := explainRule(strings.TrimPrefix(uri, ruleResourceURIPrefix), nil)
+		if err != nil {
+			return ResourceContent{}, errResourceNotFound
+		}
+		return ResourceContent{
+			URI:      uri,
+			MimeType: "text/markdown",
+			Text:     ruleMarkdown(explanation),
+		}, nil
+	}
+	return ResourceContent{}, errResourceNotFound
+}
+
+func effectiveConfigJSON() (string, error) {
+	resolved, err := resolveRules("", "", nil)
+	if err != nil {
+		return "", err
+	}
+
+	effective := EffectiveConfig{
+		ConfigPath: resolved.configPath,
+		Rules:      resolved.summaries(),
+	}
+	if resolved.configPath != "" {
+		source, err := os.ReadFile(resolved.configPath)
+		if err != nil {
+			return "", err
+		}
+		effective.Source = string(source)
+	}
+
+	bs, err := json.MarshalIndent(effective, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(bs), nil
+}
+
+// ruleMarkdown renders the explanation of the rule as a Markdown document
+func ruleMarkdown(e RuleExplanation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n%s\n\n", e.ID, e.Purpose)
+	if e.Rationale != "" {
+		fmt.Fprintf(&b, "%s\n\n", e.Rationale)
+	}
+
+	fmt.Fprintf(&b, "- Severity: %s\n", e.Severity)
+	fmt.Fprintf(&b, "- Enabled by default: %s\n", yesNo(e.Official))
+	fmt.Fprintf(&b, "- Fixable with -fix: %s\n", yesNo(e.Fixable))
+	if e.HelpURI != "" {
+		fmt.Fprintf(&b, "- Source: %s\n", e.HelpURI)
+	}
+
+	if e.Examples != nil {
+		fmt.Fprintf(&b, "\n## Bad\n\n```proto\n%s\n```\n", e.Examples.Bad)
+		fmt.Fprintf(&b, "\n## Good\n\n```proto\n%s\n```\n", e.Examples.Good)
+	}
+
+	if e.RulesOption != nil {
+		fmt.Fprintf(&b, "\n## Options\n\nConfigure the rule under `lint.rules_option.%s` with the keys:\n\n", e.RulesOption.Key)
+		for _, option := range e.RulesOption.Options {
+			fmt.Fprintf(&b, "- `%s`\n", option)
+		}
+	}
+	return b.String()
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
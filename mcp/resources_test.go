@@ -0,0 +1,219 @@
+package mcp
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestServer_handleResourcesList(t *testing.T) {
+	server := NewServer("test", io.Discard, io.Discard)
+	resp := server.handleResourcesList(&Request{
+		JSONRPC: "2.0",
+		Method:  "resources/list",
+		ID:      "test-1",
+	})
+
+	if resp.Error != nil {
+		t.Fatalf("Expected Error to be nil, got %v", resp.Error)
+	}
+	result, ok := resp.Result.(*ListResourcesResponse)
+	if !ok {
+		t.Fatalf("Expected Result to be *ListResourcesResponse, got %T", resp.Result)
+	}
+
+	want := map[string]string{
+		"protolint://config":                "application/json",
+		"protolint://rules/MAX_LINE_LENGTH": "text/markdown",
+		"protolint://rules/INDENT":          "text/markdown",
+	}
+	for _, resource := range result.Resources {
+		mimeType, ok := want[resource.URI]
+		if !ok {
+			continue
+		}
+		if resource.MimeType != mimeType {
+			t.Errorf("Expected mimeType %s for %s, got %s", mimeType, resource.URI, resource.MimeType)
+		}
+		if resource.Name == "" || resource.Description == "" {
+			t.Errorf("Expected non-empty name and description for %s, got %+v", resource.URI, resource)
+		}
+		delete(want, resource.URI)
+	}
+	if len(want) != 0 {
+		t.Errorf("Expected to find resources %v", want)
+	}
+}
+
+func TestServer_handleResourcesRead(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       string
+		wantCode     int
+		wantMimeType string
+		wantContains []string
+	}{
+		{
+			name:         "config",
+			params:       `{"uri": "protolint://config"}`,
+			wantMimeType: "application/json",
+			wantContains: []string{`"config_path"`, `"MAX_LINE_LENGTH"`},
+		},
+		{
+			name:         "rule",
+			params:       `{"uri": "protolint://rules/MAX_LINE_LENGTH"}`,
+			wantMimeType: "text/markdown",
+			wantContains: []string{"# MAX_LINE_LENGTH", "## Bad", "## Good", "`max_chars`"},
+		},
+		{
+			name:     "unknown rule",
+			params:   `{"uri": "protolint://rules/UNKNOWN_RULE"}`,
+			wantCode: -32002,
+		},
+		{
+			name:     "unknown scheme",
+			params:   `{"uri": "file:///foo.proto"}`,
+			wantCode: -32002,
+		},
+		{
+			name:     "invalid payload",
+			params:   `{"uri": `,
+			wantCode: -32602,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer("test", io.Discard, io.Discard)
+			resp := server.handleResourcesRead(&Request{
+				JSONRPC: "2.0",
+				Method:  "resources/read",
+				Params:  json.RawMessage(tt.params),
+				ID:      "test-1",
+			})
+
+			if tt.wantCode != 0 {
+				if resp.Error == nil {
+					t.Fatalf("Expected Error with code %d, got nil", tt.wantCode)
+				}
+				if resp.Error.Code != tt.wantCode {
+					t.Errorf("Expected error code %d, got %d", tt.wantCode, resp.Error.Code)
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("Expected Error to be nil, got %v", resp.Error)
+			}
+			result, ok := resp.Result.(*ReadResourceResponse)
+			if !ok {
+				t.Fatalf("Expected Result to be *ReadResourceResponse, got %T", resp.Result)
+			}
+			if len(result.Contents) != 1 {
+				t.Fatalf("Expected 1 content, got %d", len(result.Contents))
+			}
+			content := result.Contents[0]
+			if content.MimeType != tt.wantMimeType {
+				t.Errorf("Expected mimeType %s, got %s", tt.wantMimeType, content.MimeType)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(content.Text, want) {
+					t.Errorf("Expected text to contain %q, got %s", want, content.Text)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_handlePromptsList(t *testing.T) {
+	server := NewServer("test", io.Discard, io.Discard)
+	resp := server.handlePromptsList(&Request{
+		JSONRPC: "2.0",
+		Method:  "prompts/list",
+		ID:      "test-1",
+	})
+
+	result, ok := resp.Result.(*ListPromptsResponse)
+	if !ok {
+		t.Fatalf("Expected Result to be *ListPromptsResponse, got %T", resp.Result)
+	}
+	if len(result.Prompts) != 2 {
+		t.Fatalf("Expected 2 prompts, got %d", len(result.Prompts))
+	}
+	for _, prompt := range result.Prompts {
+		if len(prompt.Arguments) != 1 || prompt.Arguments[0].Name != "file" || !prompt.Arguments[0].Required {
+			t.Errorf("Expected the required file argument for %s, got %+v", prompt.Name, prompt.Arguments)
+		}
+	}
+}
+
+func TestServer_handlePromptsGet(t *testing.T) {
+	tests := []struct {
+		name         string
+		params       string
+		wantError    bool
+		wantContains []string
+	}{
+		{
+			name:         "fix-lint-failures",
+			params:       `{"name": "fix-lint-failures", "arguments": {"file": "/tmp/foo.proto"}}`,
+			wantContains: []string{"/tmp/foo.proto", "lint-files", "explain-rule"},
+		},
+		{
+			name:         "review-api-style",
+			params:       `{"name": "review-api-style", "arguments": {"file": "/tmp/foo.proto"}}`,
+			wantContains: []string{"/tmp/foo.proto", "lint-files", "- MESSAGE_NAMES_UPPER_CAMEL_CASE (error): "},
+		},
+		{
+			name:      "missing file",
+			params:    `{"name": "fix-lint-failures", "arguments": {}}`,
+			wantError: true,
+		},
+		{
+			name:      "unknown prompt",
+			params:    `{"name": "unknown-prompt"}`,
+			wantError: true,
+		},
+		{
+			name:      "invalid payload",
+			params:    `{"name": `,
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer("test", io.Discard, io.Discard)
+			resp := server.handlePromptsGet(&Request{
+				JSONRPC: "2.0",
+				Method:  "prompts/get",
+				Params:  json.RawMessage(tt.params),
+				ID:      "test-1",
+			})
+
+			if tt.wantError {
+				if resp.Error == nil || resp.Error.Code != -32602 {
+					t.Errorf("Expected Error with code -32602, got %v", resp.Error)
+				}
+				return
+			}
+
+			if resp.Error != nil {
+				t.Fatalf("Expected Error to be nil, got %v", resp.Error)
+			}
+			result, ok := resp.Result.(*GetPromptResponse)
+			if !ok {
+				t.Fatalf("Expected Result to be *GetPromptResponse, got %T", resp.Result)
+			}
+			if len(result.Messages) != 1 || result.Messages[0].Role != "user" {
+				t.Fatalf("Expected 1 user message, got %+v", result.Messages)
+			}
+			for _, want := range tt.wantContains {
+				if !strings.Contains(result.Messages[0].Content.Text, want) {
+					t.Errorf("Expected text to contain %q, got %s", want, result.Messages[0].Content.Text)
+				}
+			}
+		})
+	}
+}
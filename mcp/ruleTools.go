@@ -0,0 +1,315 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/maramkhaledn/protolint/internal/addon/plugin/shared"
+	"github.com/maramkhaledn/protolint/internal/addon/rules"
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/lint"
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/rule"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+// ListRulesTool is a tool for listing the rules and whether they are enabled
+type ListRulesTool struct {
+	// plugins are the plugins which the server started with.
+	plugins []shared.RuleSet
+}
+
+// NewListRulesTool creates a new ListRulesTool
+func NewListRulesTool() *ListRulesTool {
+	return &ListRulesTool{}
+}
+
+// GetInfo returns the tool information
+func (t *ListRulesTool) GetInfo() ToolInfo {
+	return ToolInfo{
+		Name:        "list-rules",
+		Description: "List the protolint rules with their purposes, severities, fixability and whether they are enabled under the resolved config",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"config_path": map[string]any{
+					"type":        "string",
+					"description": "Path to protolint config file. The config in the current directory is used if omitted.",
+				},
+				"file": map[string]any{
+					"type":        "string",
+					"description": "Path of a proto file to resolve the config for, taking the overrides and the excludes into account.",
+				},
+			},
+		},
+	}
+}
+
+// ListRulesArgs represents arguments for list-rules tool
+type ListRulesArgs struct {
+	ConfigPath string `json:"config_path,omitempty"`
+	File       string `json:"file,omitempty"`
+}
+
+// RuleSummary describes a rule in the result of list-rules tool
+type RuleSummary struct {
+	ID       string `json:"id"`
+	Purpose  string `json:"purpose"`
+	Severity string `json:"severity"`
+	Fixable  bool   `json:"fixable"`
+	Official bool   `json:"official"`
+	Enabled  bool   `json:"enabled"`
+}
+
+// Execute runs the list-rules tool
+func (t *ListRulesTool) Execute(ctx context.Context, args json.RawMessage) (any, error) {
+	var listArgs ListRulesArgs
+	if len(args) > 0 {
+		if err := json.Unmarshal(args, &listArgs); err != nil {
+			return nil, fmt.Errorf("invalid arguments: %v", err)
+		}
+	}
+
+	resolved, err := resolveRules(listArgs.ConfigPath, listArgs.File, t.plugins)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		"config_path": resolved.configPath,
+		"rules":       resolved.summaries(),
+	}, nil
+}
+
+// ExplainRuleTool is a tool for explaining a rule
+type ExplainRuleTool struct {
+	// plugins are the plugins which the server started with.
+	plugins []shared.RuleSet
+}
+
+// NewExplainRuleTool creates a new ExplainRuleTool
+func NewExplainRuleTool() *ExplainRuleTool {
+	return &ExplainRuleTool{}
+}
+
+// GetInfo returns the tool information
+func (t *ExplainRuleTool) GetInfo() ToolInfo {
+	return ToolInfo{
+		Name:        "explain-rule",
+		Description: "Explain a protolint rule with its purpose, rationale, good and bad examples, and the keys available in rules_option of the config",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"rule_id": map[string]any{
+					"type":        "string",
+					"description": "ID of the rule, like ENUM_FIELD_NAMES_PREFIX",
+				},
+			},
+			"required": []string{"rule_id"},
+		},
+	}
+}
+
+// ExplainRuleArgs represents arguments for explain-rule tool
+type ExplainRuleArgs struct {
+	RuleID string `json:"rule_id"`
+}
+
+// RuleExplanation is the result of explain-rule tool
+type RuleExplanation struct {
+	ID          string             `json:"id"`
+	Purpose     string             `json:"purpose"`
+	Rationale   string             `json:"rationale,omitempty"`
+	Severity    string             `json:"severity"`
+	Fixable     bool               `json:"fixable"`
+	Official    bool               `json:"official"`
+	HelpURI     string             `json:"help_uri,omitempty"`
+	Examples    *RuleExamples      `json:"examples,omitempty"`
+	RulesOption *RuleOptionSummary `json:"rules_option,omitempty"`
+}
+
+// RuleExamples shows a proto which the rule reports and the one which follows the rule
+type RuleExamples struct {
+	Bad  string `json:"bad"`
+	Good string `json:"good"`
+}
+
+// RuleOptionSummary shows where to configure the rule in the config
+type RuleOptionSummary struct {
+	Key     string   `json:"key"`
+	Options []string `json:"options"`
+}
+
+// Execute runs the explain-rule tool
+func (t *ExplainRuleTool) Execute(ctx context.Context, args json.RawMessage) (any, error) {
+	var explainArgs ExplainRuleArgs
+	if err := json.Unmarshal(args, &explainArgs); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %v", err)
+	}
+
+	if explainArgs.RuleID == "" {
+		return nil, fmt.Errorf("no rule_id specified")
+	}
+
+	explanation, err := explainRule(explainArgs.RuleID, t.plugins)
+	if err != nil {
+		return nil, err
+	}
+	return explanation, nil
+}
+
+// explainRule explains the rule with its metadata
+func explainRule(
+	ruleID string,
+	plugins []shared.RuleSet,
+) (RuleExplanation, error) {
+	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, visitor.Env{}, false, plugins)
+	if err != nil {
+		return RuleExplanation{}, err
+	}
+
+	for _, r := range allRules {
+		if r.ID() != ruleID {
+			continue
+		}
+
+		explanation := RuleExplanation{
+			ID:       r.ID(),
+			Purpose:  r.Purpose(),
+			Severity: string(r.Severity()),
+			Fixable:  isFixable(r),
+			Official: r.IsOfficial(),
+			HelpURI:  rules.HelpURI(r),
+		}
+		if documented, ok := r.(rule.HasDocumentation); ok {
+			doc := documented.Documentation()
+			explanation.Rationale = doc.Rationale
+			explanation.Examples = &RuleExamples{
+				Bad:  doc.Bad,
+				Good: doc.Good,
+			}
+		}
+		if key, options, ok := config.RuleOptionKeys(r.ID()); ok {
+			explanation.RulesOption = &RuleOptionSummary{
+				Key:     key,
+				Options: options,
+			}
+		}
+		return explanation, nil
+	}
+	return RuleExplanation{}, fmt.Errorf("rule not found: %s. Use list-rules to see the available rules", ruleID)
+}
+
+// resolvedRules is the rules under the config applied to a file
+type resolvedRules struct {
+	configPath string
+	all        []rule.Rule
+	enabled    map[string]rule.Rule
+}
+
+// summaries summarizes all rules with the severities under the config
+func (rs resolvedRules) summaries() []RuleSummary {
+	summaries := make([]RuleSummary, 0, len(rs.all))
+	for _, r := range rs.all {
+		summary := RuleSummary{
+			ID:       r.ID(),
+			Purpose:  r.Purpose(),
+			Severity: string(r.Severity()),
+			Fixable:  isFixable(r),
+			Official: r.IsOfficial(),
+		}
+		if enabled, ok := rs.enabled[r.ID()]; ok {
+			summary.Enabled = true
+			summary.Severity = string(enabled.Severity())
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+// resolveRules decides the rules enabled for the file in the same way as the lint command.
+// An empty path resolves the config without the overrides and the excludes of the files.
+func resolveRules(
+	configPath string,
+	path string,
+	plugins []shared.RuleSet,
+) (resolvedRules, error) {
+	externalConfig, err := loadExternalConfig(configPath)
+	if err != nil {
+		return resolvedRules{}, err
+	}
+
+	displayPath := toDisplayPath(path)
+	option, err := externalConfig.RulesOptionFor(displayPath)
+	if err != nil {
+		return resolvedRules{}, err
+	}
+	allRules, err := subcmds.NewAllRules(option, false, autodisable.Noop, visitor.Env{}, false, plugins)
+	if err != nil {
+		return resolvedRules{}, err
+	}
+
+	lintConfig := lint.NewCmdLintConfig(*externalConfig, lint.Flags{Plugins: plugins})
+	hasApplies, err := lintConfig.GenRules(file.NewProtoFile(path, displayPath), visitor.Env{})
+	if err != nil {
+		return resolvedRules{}, err
+	}
+	enabled := make(map[string]rule.Rule)
+	for _, r := range hasApplies {
+		if r, ok := r.(rule.Rule); ok {
+			enabled[r.ID()] = r
+		}
+	}
+
+	return resolvedRules{
+		configPath: externalConfig.SourcePath,
+		all:        allRules,
+		enabled:    enabled,
+	}, nil
+}
+
+// loadExternalConfig loads the config file, or the one in the current directory if configPath is empty.
+// The settings in the environment variables apply like the lint command.
+func loadExternalConfig(configPath string) (*config.ExternalConfig, error) {
+	settings, err := config.SettingsFromEnv(os.Environ())
+	if err != nil {
+		return nil, err
+	}
+	externalConfig, err := config.GetExternalConfigWithSettings(configPath, "", settings)
+	if err != nil {
+		return nil, err
+	}
+	if externalConfig == nil {
+		externalConfig = &config.ExternalConfig{}
+	}
+	return externalConfig, nil
+}
+
+// toDisplayPath makes the path relative to the working directory like the lint command does
+func toDisplayPath(path string) string {
+	if path == "" {
+		return path
+	}
+	if !filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		return path
+	}
+	rel, err := filepath.Rel(wd, path)
+	if err != nil {
+		return path
+	}
+	return rel
+}
+
+func isFixable(r rule.Rule) bool {
+	fixable, ok := r.(rule.HasIsFixable)
+	return ok && fixable.IsFixable()
+}
@@ -0,0 +1,295 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/maramkhaledn/protolint/internal/addon/plugin/proto"
+	"github.com/maramkhaledn/protolint/internal/addon/plugin/shared"
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds"
+	"github.com/maramkhaledn/protolint/internal/linter/config"
+	"github.com/maramkhaledn/protolint/linter/autodisable"
+	"github.com/maramkhaledn/protolint/linter/visitor"
+)
+
+const ruleToolsConfig = `lint:
+  rules:
+    add:
+      - FIELDS_HAVE_COMMENT
+    remove:
+      - MAX_LINE_LENGTH
+  rules_option:
+    indent:
+      severity: warning
+  overrides:
+    - files:
+        - legacy/**
+      rules:
+        FIELDS_HAVE_COMMENT:
+          severity: note
+`
+
+func TestListRulesTool_Execute(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".protolint.yaml")
+	if err := os.WriteFile(configPath, []byte(ruleToolsConfig), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name string
+		file string
+		want map[string]RuleSummary
+	}{
+		{
+			name: "without file",
+			want: map[string]RuleSummary{
+				"FIELDS_HAVE_COMMENT":    {Severity: "error", Enabled: true},
+				"MAX_LINE_LENGTH":        {Severity: "error", Enabled: false},
+				"INDENT":                 {Severity: "warning", Enabled: true, Fixable: true},
+				"SERVICE_NAMES_END_WITH": {Severity: "error", Enabled: false},
+			},
+		},
+		{
+			name: "with file matching the overrides",
+			file: "legacy/foo.proto",
+			want: map[string]RuleSummary{
+				"FIELDS_HAVE_COMMENT":    {Severity: "note", Enabled: true},
+				"MAX_LINE_LENGTH":        {Severity: "error", Enabled: false},
+				"INDENT":                 {Severity: "warning", Enabled: true, Fixable: true},
+				"SERVICE_NAMES_END_WITH": {Severity: "error", Enabled: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			args, _ := json.Marshal(ListRulesArgs{
+				ConfigPath: configPath,
+				File:       tt.file,
+			})
+			result, err := NewListRulesTool().Execute(context.Background(), args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			got := result.(map[string]any)
+			if got["config_path"] != configPath {
+				t.Errorf("Expected config_path %s, got %v", configPath, got["config_path"])
+			}
+			summaries := got["rules"].([]RuleSummary)
+			allRules, _ := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, visitor.Env{}, false, nil)
+			if len(summaries) != len(allRules) {
+				t.Errorf("Expected %d rules, got %d", len(allRules), len(summaries))
+			}
+
+			for _, s := range summaries {
+				want, ok := tt.want[s.ID]
+				if !ok {
+					continue
+				}
+				if s.Purpose == "" {
+					t.Errorf("Expected non-empty purpose for %s", s.ID)
+				}
+				if s.Severity != want.Severity || s.Enabled != want.Enabled || s.Fixable != want.Fixable {
+					t.Errorf("Expected %s to be severity=%s enabled=%v fixable=%v, got %+v",
+						s.ID, want.Severity, want.Enabled, want.Fixable, s)
+				}
+				delete(tt.want, s.ID)
+			}
+			if len(tt.want) != 0 {
+				t.Errorf("Expected to find rules %v", tt.want)
+			}
+		})
+	}
+}
+
+func TestListRulesTool_Execute_InvalidArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+	}{
+		{
+			name: "invalid JSON",
+			args: `{"config_path": `,
+		},
+		{
+			name: "not found config",
+			args: `{"config_path": "/not/found/.protolint.yaml"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewListRulesTool().Execute(context.Background(), json.RawMessage(tt.args))
+			if err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}
+
+func TestExplainRuleTool_Execute(t *testing.T) {
+	tests := []struct {
+		name        string
+		args        string
+		wantID      string
+		wantFixable bool
+		wantOption  *RuleOptionSummary
+		wantErr     bool
+	}{
+		{
+			name:   "rule with options",
+			args:   `{"rule_id": "MAX_LINE_LENGTH"}`,
+			wantID: "MAX_LINE_LENGTH",
+			wantOption: &RuleOptionSummary{
+				Key:     "max_line_length",
+				Options: []string{"severity", "max_chars", "tab_chars"},
+			},
+		},
+		{
+			name:        "rule with options decoded by a custom unmarshaler",
+			args:        `{"rule_id": "INDENT"}`,
+			wantID:      "INDENT",
+			wantFixable: true,
+			wantOption: &RuleOptionSummary{
+				Key:     "indent",
+				Options: []string{"severity", "style", "newline", "not_insert_newline"},
+			},
+		},
+		{
+			name:   "rule whose key doesn't follow the ID",
+			args:   `{"rule_id": "SERVICE_NAMES_UPPER_CAMEL_CASE"}`,
+			wantID: "SERVICE_NAMES_UPPER_CAMEL_CASE",
+			wantOption: &RuleOptionSummary{
+				Key:     "service_names_upper_caml_case",
+				Options: []string{"severity"},
+			},
+			wantFixable: true,
+		},
+		{
+			name:    "unknown rule",
+			args:    `{"rule_id": "UNKNOWN_RULE"}`,
+			wantErr: true,
+		},
+		{
+			name:    "missing rule_id",
+			args:    `{}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid JSON",
+			args:    `{"rule_id": `,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewExplainRuleTool().Execute(context.Background(), json.RawMessage(tt.args))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			got := result.(RuleExplanation)
+			if got.ID != tt.wantID {
+				t.Errorf("Expected ID %s, got %s", tt.wantID, got.ID)
+			}
+			if got.Fixable != tt.wantFixable {
+				t.Errorf("Expected fixable %v, got %v", tt.wantFixable, got.Fixable)
+			}
+			if !reflect.DeepEqual(got.RulesOption, tt.wantOption) {
+				t.Errorf("Expected rules_option %+v, got %+v", tt.wantOption, got.RulesOption)
+			}
+			if got.HelpURI == "" {
+				t.Error("Expected non-empty help_uri")
+			}
+		})
+	}
+}
+
+// Test that every built-in rule can be explained with its metadata
+func TestExplainRuleTool_Execute_AllRules(t *testing.T) {
+	allRules, err := subcmds.NewAllRules(config.RulesOption{}, false, autodisable.Noop, visitor.Env{}, false, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, r := range allRules {
+		t.Run(r.ID(), func(t *testing.T) {
+			args, _ := json.Marshal(ExplainRuleArgs{RuleID: r.ID()})
+			result, err := NewExplainRuleTool().Execute(context.Background(), args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			got := result.(RuleExplanation)
+			if got.Purpose == "" || got.Rationale == "" {
+				t.Errorf("Expected non-empty purpose and rationale, got %+v", got)
+			}
+			if got.Examples == nil || got.Examples.Bad == "" || got.Examples.Good == "" || got.Examples.Bad == got.Examples.Good {
+				t.Errorf("Expected different good and bad examples, got %+v", got.Examples)
+			}
+			if got.RulesOption == nil || got.RulesOption.Options[0] != "severity" {
+				t.Errorf("Expected rules_option with severity, got %+v", got.RulesOption)
+			}
+		})
+	}
+}
+
+type fakeRuleSet struct{}
+
+func (fakeRuleSet) ListRules(*proto.ListRulesRequest) (*proto.ListRulesResponse, error) {
+	return &proto.ListRulesResponse{
+		Rules: []*proto.ListRulesResponse_Rule{
+			{Id: "CUSTOM_RULE", Purpose: "Custom purpose.", Severity: proto.RuleSeverity_RULE_SEVERITY_ERROR},
+		},
+	}, nil
+}
+
+func (fakeRuleSet) Apply(*proto.ApplyRequest) (*proto.ApplyResponse, error) {
+	return &proto.ApplyResponse{}, nil
+}
+
+// Test that the rule tools take the plugins only from the server, not from the arguments
+func TestServer_SetPlugins(t *testing.T) {
+	server := NewServer("test", io.Discard, io.Discard)
+	server.SetPlugins([]shared.RuleSet{fakeRuleSet{}})
+
+	for _, tool := range server.tools {
+		info := tool.GetInfo()
+		if _, ok := info.InputSchema.(map[string]any)["properties"].(map[string]any)["plugins"]; ok {
+			t.Errorf("Expected %s not to accept plugins", info.Name)
+		}
+
+		switch tool.(type) {
+		case *ListRulesTool:
+			result, err := tool.Execute(context.Background(), json.RawMessage(`{}`))
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			found := false
+			for _, r := range result.(map[string]any)["rules"].([]RuleSummary) {
+				found = found || r.ID == "CUSTOM_RULE"
+			}
+			if !found {
+				t.Error("Expected list-rules to include CUSTOM_RULE")
+			}
+		case *ExplainRuleTool:
+			result, err := tool.Execute(context.Background(), json.RawMessage(`{"rule_id": "CUSTOM_RULE"}`))
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+			if got := result.(RuleExplanation).Purpose; got != "Custom purpose." {
+				t.Errorf("Expected purpose %q, got %q", "Custom purpose.", got)
+			}
+		}
+	}
+}
@@ -2,49 +2,116 @@
 package mcp
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"sync"
+	"sync/atomic"
+	"time"
 
+	"github.com/maramkhaledn/protolint/internal/addon/plugin/shared"
 	"github.com/maramkhaledn/protolint/internal/osutil"
 )
 
+// supportedProtocolVersions lists the MCP protocol revisions which the server supports, latest first
+var supportedProtocolVersions = []string{
+	"2025-06-18",
+	"2025-03-26",
+	"2024-11-05",
+}
+
 // Server represents an MCP server
 type Server struct {
-	tools  []Tool
-	stdout io.Writer
-	stderr io.Writer
+	version        string
+	requestTimeout time.Duration
+	tools          []Tool
+	prompts        []Prompt
+	stdout         io.Writer
+	stderr         io.Writer
 }
 
-// NewServer creates a new MCP server
-func NewServer(stdout, stderr io.Writer) *Server {
+// NewServer creates a new MCP server which reports version as its version
+func NewServer(version string, stdout, stderr io.Writer) *Server {
 	return &Server{
+		version:        version,
+		requestTimeout: defaultRequestTimeout,
 		tools: []Tool{
 			NewLintFilesTool(),
-			// Other tools can be added in the future
+			NewLintContentTool(),
+			NewListRulesTool(),
+			NewExplainRuleTool(),
+			NewOutlineFileTool(),
+			NewFindDefinitionTool(),
+		},
+		prompts: []Prompt{
+			NewFixLintFailuresPrompt(),
+			NewReviewAPIStylePrompt(),
 		},
 		stdout: stdout,
 		stderr: stderr,
 	}
 }
 
-// Run starts the MCP server
+// SetPlugins sets the plugins whose rules list-rules and explain-rule include.
+// The caller builds them once when the server starts and cleans them up after it stops,
+// so that the tools never run the commands from the clients.
+func (s *Server) SetPlugins(plugins []shared.RuleSet) {
+	for _, tool := range s.tools {
+		switch t := tool.(type) {
+		case *ListRulesTool:
+			t.plugins = plugins
+		case *ExplainRuleTool:
+			t.plugins = plugins
+		}
+	}
+}
+
+// Run starts the MCP server reading the requests from stdin
 func (s *Server) Run() osutil.ExitCode {
 	_, _ = fmt.Fprintf(s.stderr, "protolint MCP server is running. cwd: %s\n", getCurrentDir())
+	return s.Serve(os.Stdin)
+}
 
-	decoder := json.NewDecoder(os.Stdin)
+// Serve reads the requests from r until EOF and handles them concurrently.
+// It waits for the requests being handled before returning.
+func (s *Server) Serve(r io.Reader) osutil.ExitCode {
+	decoder := json.NewDecoder(r)
 	encoder := json.NewEncoder(s.stdout)
 
+	var (
+		encoderMu    sync.Mutex
+		encodeFailed atomic.Bool
+		wg           sync.WaitGroup
+	)
+	// write sends a response or a notification. The responses to the concurrent requests must not interleave.
+	write := func(message any) {
+		encoderMu.Lock()
+		defer encoderMu.Unlock()
+		if err := encoder.Encode(message); err != nil {
+			_, _ = fmt.Fprintf(s.stderr, "Error encoding response: %v\n", err)
+			encodeFailed.Store(true)
+		}
+	}
+	notify := func(method string, params interface{}) {
+		write(&Notification{
+			JSONRPC: "2.0",
+			Method:  method,
+			Params:  params,
+		})
+	}
+	inflight := newInflightRequests()
+
+	exitCode := osutil.ExitSuccess
 	for {
 		var request Request
 		if err := decoder.Decode(&request); err != nil {
-			if err == io.EOF {
-				// Normal termination
-				return osutil.ExitSuccess
+			if err != io.EOF {
+				_, _ = fmt.Fprintf(s.stderr, "Error decoding request: %v\n", err)
+				exitCode = osutil.ExitInternalFailure
 			}
-			_, _ = fmt.Fprintf(s.stderr, "Error decoding request: %v\n", err)
-			return osutil.ExitInternalFailure
+			break
 		}
 
 		// Ensure JSONRPC version is set
@@ -52,22 +119,27 @@ func (s *Server) Run() osutil.ExitCode {
 			request.JSONRPC = "2.0"
 		}
 
-		// Process the request
-		response := s.handleRequest(&request)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
 
-		// Only encode and send a response if there is one
-		// Notifications don't require a response
-		if response != nil {
-			if err := encoder.Encode(response); err != nil {
-				_, _ = fmt.Fprintf(s.stderr, "Error encoding response: %v\n", err)
-				return osutil.ExitInternalFailure
+			// Only encode and send a response if there is one
+			// Notifications don't require a response
+			if response := s.handleMessage(context.Background(), inflight, &request, notify); response != nil {
+				write(response)
 			}
-		}
+		}()
 	}
+
+	wg.Wait()
+	if encodeFailed.Load() {
+		return osutil.ExitInternalFailure
+	}
+	return exitCode
 }
 
 // handleRequest handles a single JSON-RPC request
-func (s *Server) handleRequest(req *Request) *Response {
+func (s *Server) handleRequest(ctx context.Context, req *Request) *Response {
 	switch req.Method {
 	case "initialize":
 		return s.handleInitialize(req)
@@ -78,7 +150,15 @@ func (s *Server) handleRequest(req *Request) *Response {
 	case "tools/list":
 		return s.handleToolsList(req)
 	case "tools/call":
-		return s.handleToolsCall(req)
+		return s.handleToolsCall(ctx, req)
+	case "resources/list":
+		return s.handleResourcesList(req)
+	case "resources/read":
+		return s.handleResourcesRead(req)
+	case "prompts/list":
+		return s.handlePromptsList(req)
+	case "prompts/get":
+		return s.handlePromptsGet(req)
 	default:
 		return &Response{
 			JSONRPC: "2.0",
@@ -119,27 +199,32 @@ func (s *Server) handleInitialize(req *Request) *Response {
 		_, _ = fmt.Fprintf(s.stderr, "Client protocol version: %s\n", params.ProtocolVersion)
 	}
 
-	// Check if we support the client's protocol version
-	// We only support 2024-11-05
-	supportedVersion := "2024-11-05"
-	if params.ProtocolVersion != "" && params.ProtocolVersion != supportedVersion {
+	// Respond with the client's protocol version if we support it, or with our latest one.
+	// The client will judge compatibility with the latter.
+	protocolVersion := negotiateProtocolVersion(params.ProtocolVersion)
+	if params.ProtocolVersion != "" && params.ProtocolVersion != protocolVersion {
 		_, _ = fmt.Fprintf(s.stderr, "Warning: Client requested protocol version %s, but we're responding with %s\n",
-			params.ProtocolVersion, supportedVersion)
-		// We still continue with our supported version - the client will judge compatibility
+			params.ProtocolVersion, protocolVersion)
 	}
 
 	// Create initialize result with proper MCP protocol capabilities
 	result := InitializeResult{
-		ProtocolVersion: supportedVersion,
+		ProtocolVersion: protocolVersion,
 		ServerInfo: ServerInfo{
 			Name:    "protolint-mcp",
-			Version: "1.0.0",
+			Version: s.version,
 		},
 		Capabilities: ServerCapabilities{
-			// We only support tools with listChanged capability
 			Tools: map[string]interface{}{
 				"listChanged": true,
 			},
+			// The resources and the prompts don't change while running
+			Resources: map[string]interface{}{
+				"listChanged": false,
+			},
+			Prompts: map[string]interface{}{
+				"listChanged": false,
+			},
 		},
 		Instructions: "protolint: Protocol Buffer linter and fixer for enforcing proto style guide rules",
 	}
@@ -151,6 +236,23 @@ func (s *Server) handleInitialize(req *Request) *Response {
 	}
 }
 
+// negotiateProtocolVersion returns the requested version if it is supported, and the latest supported version otherwise
+func negotiateProtocolVersion(requested string) string {
+	if isSupportedProtocolVersion(requested) {
+		return requested
+	}
+	return supportedProtocolVersions[0]
+}
+
+func isSupportedProtocolVersion(version string) bool {
+	for _, supported := range supportedProtocolVersions {
+		if version == supported {
+			return true
+		}
+	}
+	return false
+}
+
 // handleToolsList handles tools/list request
 func (s *Server) handleToolsList(req *Request) *Response {
 	toolInfos := make([]ToolInfo, 0, len(s.tools))
@@ -168,7 +270,7 @@ func (s *Server) handleToolsList(req *Request) *Response {
 }
 
 // handleToolsCall handles tools/call request
-func (s *Server) handleToolsCall(req *Request) *Response {
+func (s *Server) handleToolsCall(ctx context.Context, req *Request) *Response {
 	var payload CallToolPayload
 	if err := json.Unmarshal(req.Params, &payload); err != nil {
 		return &Response{
@@ -201,8 +303,32 @@ func (s *Server) handleToolsCall(req *Request) *Response {
 		}
 	}
 
-	// Execute the tool
-	result, err := tool.Execute(payload.Arguments)
+	if payload.Meta != nil {
+		var stopProgress func()
+		ctx, stopProgress = withProgressToken(ctx, payload.Meta.ProgressToken)
+		defer stopProgress()
+	}
+
+	// Execute the tool, giving up on it when the request is cancelled or times out.
+	// The tools stop early by watching ctx, like lint-files which stops before the next rule.
+	type toolResult struct {
+		result any
+		err    error
+	}
+	done := make(chan toolResult, 1)
+	go func() {
+		result, err := tool.Execute(ctx, payload.Arguments)
+		done <- toolResult{result: result, err: err}
+	}()
+
+	var result any
+	var err error
+	select {
+	case r := <-done:
+		result, err = r.result, r.err
+	case <-ctx.Done():
+		err = context.Cause(ctx)
+	}
 	if err != nil {
 		return &Response{
 			JSONRPC: "2.0",
@@ -242,6 +368,145 @@ func (s *Server) handleToolsCall(req *Request) *Response {
 	}
 }
 
+// handleResourcesList handles resources/list request
+func (s *Server) handleResourcesList(req *Request) *Response {
+	resources, err := listResources()
+	if err != nil {
+		return &Response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &Error{
+				Code:    -32603, // Internal error
+				Message: fmt.Sprintf("Failed to list resources: %v", err),
+			},
+		}
+	}
+
+	return &Response{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result: &ListResourcesResponse{
+			Resources: resources,
+		},
+	}
+}
+
+// handleResourcesRead handles resources/read request
+func (s *Server) handleResourcesRead(req *Request) *Response {
+	var payload ReadResourcePayload
+	if err := json.Unmarshal(req.Params, &payload); err != nil {
+		return &Response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &Error{
+				Code:    -32602, // Invalid params
+				Message: fmt.Sprintf("Invalid payload: %v", err),
+			},
+		}
+	}
+
+	content, err := readResource(payload.URI)
+	if err == errResourceNotFound {
+		return &Response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &Error{
+				Code:    -32002, // Resource not found
+				Message: fmt.Sprintf("Resource not found: %s", payload.URI),
+				Data: map[string]interface{}{
+					"uri": payload.URI,
+				},
+			},
+		}
+	}
+	if err != nil {
+		return &Response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &Error{
+				Code:    -32603, // Internal error
+				Message: fmt.Sprintf("Failed to read resource: %v", err),
+			},
+		}
+	}
+
+	return &Response{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result: &ReadResourceResponse{
+			Contents: []ResourceContent{content},
+		},
+	}
+}
+
+// handlePromptsList handles prompts/list request
+func (s *Server) handlePromptsList(req *Request) *Response {
+	promptInfos := make([]PromptInfo, 0, len(s.prompts))
+	for _, prompt := range s.prompts {
+		promptInfos = append(promptInfos, prompt.GetInfo())
+	}
+
+	return &Response{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result: &ListPromptsResponse{
+			Prompts: promptInfos,
+		},
+	}
+}
+
+// handlePromptsGet handles prompts/get request
+func (s *Server) handlePromptsGet(req *Request) *Response {
+	var payload GetPromptPayload
+	if err := json.Unmarshal(req.Params, &payload); err != nil {
+		return &Response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &Error{
+				Code:    -32602, // Invalid params
+				Message: fmt.Sprintf("Invalid payload: %v", err),
+			},
+		}
+	}
+
+	var prompt Prompt
+	for _, p := range s.prompts {
+		if p.GetInfo().Name == payload.Name {
+			prompt = p
+			break
+		}
+	}
+
+	if prompt == nil {
+		return &Response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &Error{
+				Code:    -32602, // Invalid params
+				Message: fmt.Sprintf("Prompt not found: %s", payload.Name),
+			},
+		}
+	}
+
+	result, err := prompt.Get(payload.Arguments)
+	if err != nil {
+		return &Response{
+			JSONRPC: "2.0",
+			ID:      req.ID,
+			Error: &Error{
+				Code:    -32602, // Invalid params
+				Message: fmt.Sprintf("Failed to get prompt: %v", err),
+			},
+		}
+	}
+
+	return &Response{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Result:  result,
+	}
+}
+
 // getCurrentDir returns the current working directory
 func getCurrentDir() string {
 	dir, err := os.Getwd()
@@ -1,13 +1,14 @@
 package mcp
 
 import (
+	"context"
 	"io"
 	"reflect"
 	"testing"
 )
 
 func TestServer_handleInitialize_Success(t *testing.T) {
-	server := NewServer(io.Discard, io.Discard)
+	server := NewServer("test", io.Discard, io.Discard)
 	req := &Request{
 		JSONRPC: "2.0",
 		Method:  "initialize",
@@ -65,15 +66,21 @@ func TestServer_handleInitialize_Success(t *testing.T) {
 			t.Errorf("Expected capabilities.tools.listChanged to be present")
 		}
 	}
+	if result.Capabilities.Resources == nil {
+		t.Errorf("Expected capabilities.resources to be non-nil")
+	}
+	if result.Capabilities.Prompts == nil {
+		t.Errorf("Expected capabilities.prompts to be non-nil")
+	}
 }
 
 func TestServer_handleInitialize_DifferentVersion(t *testing.T) {
-	server := NewServer(io.Discard, io.Discard)
+	server := NewServer("test", io.Discard, io.Discard)
 	req := &Request{
 		JSONRPC: "2.0",
 		Method:  "initialize",
 		Params: []byte(`{
-			"protocolVersion": "2025-03-26",
+			"protocolVersion": "2099-01-01",
 			"capabilities": {
 				"roots": {},
 				"sampling": {}
@@ -107,14 +114,70 @@ func TestServer_handleInitialize_DifferentVersion(t *testing.T) {
 		t.Fatalf("Expected Result to be InitializeResult, got %T", resp.Result)
 	}
 
-	// Check protocol version is our supported version
-	if result.ProtocolVersion != "2024-11-05" {
-		t.Errorf("Expected protocolVersion to be '2024-11-05', got '%v'", result.ProtocolVersion)
+	// Check protocol version is our latest supported version
+	if result.ProtocolVersion != "2025-06-18" {
+		t.Errorf("Expected protocolVersion to be '2025-06-18', got '%v'", result.ProtocolVersion)
+	}
+}
+
+func TestServer_handleInitialize_Version(t *testing.T) {
+	tests := []struct {
+		name                string
+		protocolVersion     string
+		wantProtocolVersion string
+	}{
+		{
+			name:                "2024-11-05",
+			protocolVersion:     "2024-11-05",
+			wantProtocolVersion: "2024-11-05",
+		},
+		{
+			name:                "2025-03-26",
+			protocolVersion:     "2025-03-26",
+			wantProtocolVersion: "2025-03-26",
+		},
+		{
+			name:                "2025-06-18",
+			protocolVersion:     "2025-06-18",
+			wantProtocolVersion: "2025-06-18",
+		},
+		{
+			name:                "unsupported version",
+			protocolVersion:     "2023-01-01",
+			wantProtocolVersion: "2025-06-18",
+		},
+		{
+			name:                "no version",
+			wantProtocolVersion: "2025-06-18",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := NewServer("v0.99.0", io.Discard, io.Discard)
+			resp := server.handleInitialize(&Request{
+				JSONRPC: "2.0",
+				Method:  "initialize",
+				Params:  []byte(`{"protocolVersion": "` + tt.protocolVersion + `", "capabilities": {}}`),
+				ID:      float64(1),
+			})
+
+			result, ok := resp.Result.(InitializeResult)
+			if !ok {
+				t.Fatalf("Expected Result to be InitializeResult, got %T", resp.Result)
+			}
+			if result.ProtocolVersion != tt.wantProtocolVersion {
+				t.Errorf("Expected protocolVersion to be '%s', got '%s'", tt.wantProtocolVersion, result.ProtocolVersion)
+			}
+			if result.ServerInfo.Version != "v0.99.0" {
+				t.Errorf("Expected serverInfo.version to be 'v0.99.0', got '%s'", result.ServerInfo.Version)
+			}
+		})
 	}
 }
 
 func TestServer_handleListTools(t *testing.T) {
-	server := NewServer(io.Discard, io.Discard)
+	server := NewServer("test", io.Discard, io.Discard)
 	req := &Request{
 		JSONRPC: "2.0",
 		Method:  "list_tools",
@@ -159,7 +222,7 @@ func TestServer_handleListTools(t *testing.T) {
 }
 
 func TestServer_handleInitializedNotification(t *testing.T) {
-	server := NewServer(io.Discard, io.Discard)
+	server := NewServer("test", io.Discard, io.Discard)
 	req := &Request{
 		JSONRPC: "2.0",
 		Method:  "notifications/initialized",
@@ -168,21 +231,21 @@ func TestServer_handleInitializedNotification(t *testing.T) {
 	}
 
 	// For notifications, we expect nil response
-	resp := server.handleRequest(req)
+	resp := server.handleRequest(context.Background(), req)
 	if resp != nil {
 		t.Errorf("Expected nil response for notification, got %v", resp)
 	}
 }
 
 func TestServer_handleToolsList(t *testing.T) {
-	server := NewServer(io.Discard, io.Discard)
+	server := NewServer("test", io.Discard, io.Discard)
 	req := &Request{
 		JSONRPC: "2.0",
 		Method:  "tools/list",
 		ID:      "test-alt",
 	}
 
-	resp := server.handleRequest(req)
+	resp := server.handleRequest(context.Background(), req)
 
 	if resp.JSONRPC != "2.0" {
 		t.Errorf("Expected JSONRPC version to be '2.0', got '%s'", resp.JSONRPC)
@@ -257,8 +320,8 @@ func TestServer_handleCallTool(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			server := NewServer(io.Discard, io.Discard)
-			resp := server.handleToolsCall(tt.request)
+			server := NewServer("test", io.Discard, io.Discard)
+			resp := server.handleToolsCall(context.Background(), tt.request)
 
 			if resp.JSONRPC != "2.0" {
 				t.Errorf("Expected JSONRPC version to be '2.0', got '%s'", resp.JSONRPC)
@@ -315,6 +378,30 @@ func TestServer_handleRequest(t *testing.T) {
 			wantPayload:    reflect.TypeOf(&ListToolsResponse{}),
 			isNotification: false,
 		},
+		{
+			name: "list_resources request",
+			request: &Request{
+				JSONRPC: "2.0",
+				Method:  "resources/list",
+				ID:      "test-3",
+			},
+			wantError:      false,
+			wantResult:     true,
+			wantPayload:    reflect.TypeOf(&ListResourcesResponse{}),
+			isNotification: false,
+		},
+		{
+			name: "list_prompts request",
+			request: &Request{
+				JSONRPC: "2.0",
+				Method:  "prompts/list",
+				ID:      "test-4",
+			},
+			wantError:      false,
+			wantResult:     true,
+			wantPayload:    reflect.TypeOf(&ListPromptsResponse{}),
+			isNotification: false,
+		},
 		{
 			name: "initialized notification",
 			request: &Request{
@@ -343,8 +430,8 @@ func TestServer_handleRequest(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			server := NewServer(io.Discard, io.Discard)
-			resp := server.handleRequest(tt.request)
+			server := NewServer("test", io.Discard, io.Discard)
+			resp := server.handleRequest(context.Background(), tt.request)
 
 			// Notifications should return nil responses
 			if tt.isNotification {
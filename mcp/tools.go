@@ -3,16 +3,26 @@ package mcp
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 
+	"github.com/yoheimuta/go-protoparser/v4/parser"
+
+	"github.com/maramkhaledn/protolint/internal/cmd/subcmds/lint"
 	"github.com/maramkhaledn/protolint/internal/libinternal"
+	"github.com/maramkhaledn/protolint/internal/linter"
+	"github.com/maramkhaledn/protolint/internal/linter/file"
+	"github.com/maramkhaledn/protolint/internal/osutil"
+	"github.com/maramkhaledn/protolint/linter/report"
+	"github.com/maramkhaledn/protolint/linter/visitor"
 )
 
 // Tool defines the interface for MCP tools
 type Tool interface {
 	GetInfo() ToolInfo
-	Execute(args json.RawMessage) (any, error)
+	// Execute runs the tool. It should stop early and return the cause of ctx when ctx is done.
+	Execute(ctx context.Context, args json.RawMessage) (any, error)
 }
 
 // LintFilesTool is a tool for linting Proto files
@@ -60,7 +70,7 @@ type LintFilesArgs struct {
 }
 
 // Execute runs the lint-files tool
-func (t *LintFilesTool) Execute(args json.RawMessage) (any, error) {
+func (t *LintFilesTool) Execute(ctx context.Context, args json.RawMessage) (any, error) {
 	var lintArgs LintFilesArgs
 	if err := json.Unmarshal(args, &lintArgs); err != nil {
 		return nil, fmt.Errorf("invalid arguments: %v", err)
@@ -91,15 +101,24 @@ func (t *LintFilesTool) Execute(args json.RawMessage) (any, error) {
 	var outputBuffer bytes.Buffer
 	var errorBuffer bytes.Buffer
 
-	// Run lint command
-	err := libinternal.Lint(cmdArgs, &outputBuffer, &errorBuffer)
+	// Run lint command over all the files at once, reporting the progress after each file
+	lintCtx := libinternal.WithFileLinted(ctx, func(path string, linted, total int) {
+		reportProgress(ctx, linted, total, fmt.Sprintf("Linted %s", path))
+	})
+	err := libinternal.LintContext(lintCtx, cmdArgs, &outputBuffer, &errorBuffer)
+	if ctxErr := context.Cause(ctx); ctxErr != nil {
+		return nil, ctxErr
+	}
 
 	// Determine exit code based on error
 	exitCode := 0
 	if err != nil {
-		if err == libinternal.ErrLintFailure {
+		switch err {
+		case libinternal.ErrLintFailure:
 			exitCode = 1
-		} else {
+		case libinternal.ErrLintWarningFailure:
+			exitCode = 3
+		default:
 			exitCode = 2
 			// Return error information if internal error occurred
 			return map[string]any{
@@ -126,3 +145,160 @@ func (t *LintFilesTool) Execute(args json.RawMessage) (any, error) {
 
 	return result, nil
 }
+
+// LintContentTool is a tool for linting Proto content which is not saved in a file
+type LintContentTool struct{}
+
+// NewLintContentTool creates a new LintContentTool
+func NewLintContentTool() *LintContentTool {
+	return &LintContentTool{}
+}
+
+// GetInfo returns the tool information
+func (t *LintContentTool) GetInfo() ToolInfo {
+	return ToolInfo{
+		Name:        "lint-content",
+		Description: "Lint and fix Protocol Buffer source text using protolint without reading or writing the file. The config which would apply to the filename is used.",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"content": map[string]any{
+					"type":        "string",
+					"description": "Source text of the proto file",
+				},
+				"filename": map[string]any{
+					"type":        "string",
+					"description": "Virtual path of the proto file, like path/to/file.proto. It decides the config applied to the content and the file name checked by the rules. The file doesn't need to exist.",
+				},
+				"config_path": map[string]any{
+					"type":        "string",
+					"description": "Path to protolint config file. The config in the current directory is used if omitted.",
+				},
+				"fix": map[string]any{
+					"type":        "boolean",
+					"description": "Return the fixed content in fixed_content. Default is false. The failures are the ones found before fixing.",
+				},
+			},
+			"required": []string{"content", "filename"},
+		},
+	}
+}
+
+// LintContentArgs represents arguments for lint-content tool
+type LintContentArgs struct {
+	Content    *string `json:"content"`
+	Filename   string  `json:"filename"`
+	ConfigPath string  `json:"config_path,omitempty"`
+	Fix        bool    `json:"fix,omitempty"`
+}
+
+// ContentFailure represents a failure in the result of lint-content tool
+type ContentFailure struct {
+	RuleID    string `json:"rule_id"`
+	Message   string `json:"message"`
+	Line      int    `json:"line"`
+	Column    int    `json:"column"`
+	EndLine   int    `json:"end_line,omitempty"`
+	EndColumn int    `json:"end_column,omitempty"`
+	Severity  string `json:"severity"`
+}
+
+// LintContentResult is the result of lint-content tool
+type LintContentResult struct {
+	Filename string           `json:"filename"`
+	ExitCode int              `json:"exit_code"`
+	Failures []ContentFailure `json:"failures"`
+	// FixedContent is set only when fix is requested.
+	FixedContent *string `json:"fixed_content,omitempty"`
+	// FixedFilename is set only when the fixer renamed the file.
+	FixedFilename string `json:"fixed_filename,omitempty"`
+}
+
+// Execute runs the lint-content tool
+func (t *LintContentTool) Execute(ctx context.Context, args json.RawMessage) (any, error) {
+	var lintArgs LintContentArgs
+	if err := json.Unmarshal(args, &lintArgs); err != nil {
+		return nil, fmt.Errorf("invalid arguments: %v", err)
+	}
+
+	if lintArgs.Content == nil {
+		return nil, fmt.Errorf("no content specified")
+	}
+	if lintArgs.Filename == "" {
+		return nil, fmt.Errorf("no filename specified")
+	}
+
+	externalConfig, err := loadExternalConfig(lintArgs.ConfigPath)
+	if err != nil {
+		return nil, err
+	}
+	lintConfig := lint.NewCmdLintConfig(*externalConfig, lint.Flags{FixMode: lintArgs.Fix})
+
+	displayPath := toDisplayPath(lintArgs.Filename)
+	f := file.NewProtoFile(displayPath, displayPath)
+
+	// The rules and the fixers of this call read and write the content instead of the disk.
+	files := osutil.NewFiles()
+	files.Add(displayPath, []byte(*lintArgs.Content))
+	rs, err := lintConfig.GenRules(f, visitor.Env{Files: files})
+	if err != nil {
+		return nil, err
+	}
+
+	var failures []report.Failure
+	if 0 < len(rs) {
+		failures, err = linter.NewLinter().Run(func(p *parser.Proto) (*parser.Proto, error) {
+			// Stop before the next rule when the request is cancelled or times out.
+			if err := context.Cause(ctx); err != nil {
+				return nil, err
+			}
+			// Follow the rename by the previous rule.
+			if p != nil && p.Meta.Filename != f.DisplayPath() {
+				f = file.NewProtoFile(p.Meta.Filename, p.Meta.Filename)
+			}
+			proto, err := f.ParseWithFiles(files, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %s: %v", displayPath, err)
+			}
+			return proto, nil
+		}, rs)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	result := LintContentResult{
+		Filename: displayPath,
+		ExitCode: int(lintConfig.ExitCode(failures)),
+		Failures: make([]ContentFailure, 0, len(failures)),
+	}
+	for _, failure := range failures {
+		cf := ContentFailure{
+			RuleID:   failure.RuleID(),
+			Message:  failure.Message(),
+			Line:     failure.Pos().Line,
+			Column:   failure.Pos().Column,
+			Severity: failure.Severity(),
+		}
+		if failure.HasRange() {
+			cf.EndLine = failure.End().Line
+			cf.EndColumn = failure.End().Column
+		}
+		result.Failures = append(result.Failures, cf)
+	}
+
+	if lintArgs.Fix {
+		// The fixers may have renamed the file.
+		path := files.Paths()[0]
+		content, err := files.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		fixed := string(content)
+		result.FixedContent = &fixed
+		if path != displayPath {
+			result.FixedFilename = path
+		}
+	}
+	return result, nil
+}
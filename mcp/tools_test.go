@@ -1,7 +1,10 @@
 package mcp
 
 import (
+	"context"
 	"encoding/json"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -83,7 +86,7 @@ func TestLintFilesTool_Execute_InvalidArgs(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			tool := NewLintFilesTool()
-			_, err := tool.Execute(json.RawMessage(tt.args))
+			_, err := tool.Execute(context.Background(), json.RawMessage(tt.args))
 
 			if (err != nil) != tt.wantErr {
 				t.Errorf("Execute() error = %v, wantErr %v", err, tt.wantErr)
@@ -154,3 +157,158 @@ func TestLintFilesArgs_Unmarshal(t *testing.T) {
 		})
 	}
 }
+
+func TestLintContentTool_Execute(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), ".protolint.yaml")
+	config := `lint:
+  rules:
+    no_default: true
+    add:
+      - FILE_NAMES_LOWER_SNAKE_CASE
+      - IMPORTS_SORTED
+      - QUOTE_CONSISTENT
+  overrides:
+    - files:
+        - legacy/**
+      rules:
+        IMPORTS_SORTED:
+          severity: warning
+`
+	if err := os.WriteFile(configPath, []byte(config), 0644); err != nil {
+		t.Fatal(err)
+	}
+	content := "syntax = 'proto3';\nimport \"b.proto\";\nimport \"a.proto\";\n"
+	fixed := "syntax = \"proto3\";\nimport \"a.proto\";\nimport \"b.proto\";\n"
+
+	tests := []struct {
+		name     string
+		args     LintContentArgs
+		want     LintContentResult
+		wantRule []string
+	}{
+		{
+			name: "lint without fix",
+			args: LintContentArgs{
+				Content:  &content,
+				Filename: "proto/foo.proto",
+			},
+			want: LintContentResult{
+				Filename: filepath.Join("proto", "foo.proto"),
+				ExitCode: 1,
+			},
+			wantRule: []string{"QUOTE_CONSISTENT", "IMPORTS_SORTED", "IMPORTS_SORTED"},
+		},
+		{
+			name: "lint with fix",
+			args: LintContentArgs{
+				Content:  &content,
+				Filename: "proto/Foo.proto",
+				Fix:      true,
+			},
+			want: LintContentResult{
+				Filename:      filepath.Join("proto", "Foo.proto"),
+				ExitCode:      1,
+				FixedContent:  &fixed,
+				FixedFilename: filepath.Join("proto", "foo.proto"),
+			},
+			wantRule: []string{"FILE_NAMES_LOWER_SNAKE_CASE", "QUOTE_CONSISTENT", "IMPORTS_SORTED", "IMPORTS_SORTED"},
+		},
+		{
+			name: "lint with the config for the path",
+			args: LintContentArgs{
+				Content:  &fixed,
+				Filename: "legacy/foo.proto",
+			},
+			want: LintContentResult{
+				Filename: filepath.Join("legacy", "foo.proto"),
+				ExitCode: 0,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.args.ConfigPath = configPath
+			args, _ := json.Marshal(tt.args)
+			result, err := NewLintContentTool().Execute(context.Background(), args)
+			if err != nil {
+				t.Fatalf("Execute() error = %v", err)
+			}
+
+			got := result.(LintContentResult)
+			var gotRules []string
+			for _, f := range got.Failures {
+				gotRules = append(gotRules, f.RuleID)
+			}
+			if !reflect.DeepEqual(gotRules, tt.wantRule) {
+				t.Errorf("Expected failures of %v, got %v", tt.wantRule, gotRules)
+			}
+			got.Failures = nil
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Execute() = %+v, want %+v", got, tt.want)
+			}
+			if _, err := os.Stat(tt.args.Filename); !os.IsNotExist(err) {
+				t.Errorf("Expected no file at %s, got err %v", tt.args.Filename, err)
+			}
+		})
+	}
+}
+
+func TestLintContentTool_Execute_Failure(t *testing.T) {
+	args := `{"content": "syntax = \"proto3\";\nenum foo {\n  BAR = 0;\n}\n", "filename": "foo.proto"}`
+	result, err := NewLintContentTool().Execute(context.Background(), json.RawMessage(args))
+	if err != nil {
+		t.Fatalf("Execute() error = %v", err)
+	}
+
+	var want ContentFailure
+	for _, f := range result.(LintContentResult).Failures {
+		if f.RuleID == "ENUM_NAMES_UPPER_CAMEL_CASE" {
+			want = f
+		}
+	}
+	if want != (ContentFailure{
+		RuleID:    "ENUM_NAMES_UPPER_CAMEL_CASE",
+		Message:   `Enum name "foo" must be UpperCamelCase like "Foo"`,
+		Line:      2,
+		Column:    1,
+		EndLine:   2,
+		EndColumn: 8,
+		Severity:  "error",
+	}) {
+		t.Errorf("Expected the failure of ENUM_NAMES_UPPER_CAMEL_CASE, got %+v", want)
+	}
+}
+
+func TestLintContentTool_Execute_InvalidArgs(t *testing.T) {
+	tests := []struct {
+		name string
+		args string
+	}{
+		{
+			name: "invalid JSON",
+			args: `{"content": `,
+		},
+		{
+			name: "missing content",
+			args: `{"filename": "foo.proto"}`,
+		},
+		{
+			name: "missing filename",
+			args: `{"content": "syntax = \"proto3\";"}`,
+		},
+		{
+			name: "unparsable content",
+			args: `{"content": "message {", "filename": "foo.proto"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewLintContentTool().Execute(context.Background(), json.RawMessage(tt.args))
+			if err == nil {
+				t.Error("Expected an error")
+			}
+		})
+	}
+}